@@ -0,0 +1,25 @@
+package lazy
+
+import (
+	"slices"
+	"sync"
+)
+
+type Accumulator[T any] struct {
+	mu     sync.Mutex
+	values []T
+}
+
+func (a *Accumulator[T]) Push(value T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.values = append(a.values, value)
+}
+
+func (a *Accumulator[T]) Snapshot() Value[[]T] {
+	return NewLazy(func() []T {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		return slices.Clone(a.values)
+	}).describedAs("Snapshot")
+}
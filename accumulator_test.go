@@ -0,0 +1,73 @@
+package lazy
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	t.Run("snapshot reflects pushes at force time", func(t *testing.T) {
+		var acc Accumulator[int]
+		snapshot := acc.Snapshot()
+
+		acc.Push(1)
+		acc.Push(2)
+		if got := snapshot.Get(); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("Snapshot().Get() = %v, want [1 2]", got)
+		}
+
+		acc.Push(3)
+		if got := snapshot.Get(); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("Snapshot().Get() after push = %v, want [1 2 3]", got)
+		}
+	})
+
+	t.Run("snapshot is a copy", func(t *testing.T) {
+		var acc Accumulator[int]
+		acc.Push(1)
+
+		got := acc.Snapshot().Get()
+		got[0] = 100
+		if again := acc.Snapshot().Get(); again[0] != 1 {
+			t.Errorf("Snapshot().Get()[0] = %v after mutating a previous snapshot, want 1", again[0])
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var acc Accumulator[string]
+		if got := acc.Snapshot().Get(); len(got) != 0 {
+			t.Errorf("Snapshot().Get() = %v, want empty", got)
+		}
+	})
+
+	t.Run("concurrent push and snapshot", func(t *testing.T) {
+		var acc Accumulator[int]
+		snapshot := acc.Snapshot()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				acc.Push(i)
+			}()
+			go func() {
+				defer wg.Done()
+				snapshot.Get()
+			}()
+		}
+		wg.Wait()
+
+		got := snapshot.Get()
+		if len(got) != 100 {
+			t.Fatalf("Snapshot().Get() has %d items, want 100", len(got))
+		}
+		slices.Sort(got)
+		for i, v := range got {
+			if v != i {
+				t.Errorf("Sorted snapshot[%d] = %v, want %d", i, v, i)
+			}
+		}
+	})
+}
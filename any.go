@@ -0,0 +1,28 @@
+package lazy
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func GetAs[T any](v Value[any]) (T, bool) {
+	value, ok := v.Get().(T)
+	return value, ok
+}
+
+func ToAny[T any](v Value[T]) Value[any] {
+	return NewLazy(func() any {
+		return v.Get()
+	}).describedAs("ToAny", v)
+}
+
+func FromAny[T any](v Value[any]) Value[T] {
+	return NewLazy(func() T {
+		value := v.Get()
+		typed, ok := value.(T)
+		if !ok {
+			panic(fmt.Errorf("lazy: FromAny: value of type %T is not %v", value, reflect.TypeFor[T]()))
+		}
+		return typed
+	}).describedAs("FromAny", v)
+}
@@ -0,0 +1,86 @@
+package lazy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetAs(t *testing.T) {
+	t.Run("matching type", func(t *testing.T) {
+		got, ok := GetAs[int](New[any](42))
+		if !ok || got != 42 {
+			t.Errorf("GetAs[int](42) = %v, %v, want 42, true", got, ok)
+		}
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		got, ok := GetAs[string](New[any](42))
+		if ok || got != "" {
+			t.Errorf("GetAs[string](42) = %q, %v, want \"\", false", got, ok)
+		}
+	})
+
+	t.Run("interface type", func(t *testing.T) {
+		val := NewLazy(func() any { return 3 })
+		if _, ok := GetAs[interface{ String() string }](val); ok {
+			t.Error("GetAs[Stringer](3) should fail")
+		}
+		if _, ok := GetAs[any](val); !ok {
+			t.Error("GetAs[any](3) should succeed")
+		}
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		var val Value[any]
+		if _, ok := GetAs[int](val); ok {
+			t.Error("GetAs[int] on zero Value should fail")
+		}
+	})
+}
+
+func TestToAnyFromAny(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		val := FromAny[int](ToAny(New(42)))
+		if got := val.Get(); got != 42 {
+			t.Errorf("FromAny(ToAny(42)).Get() = %v, want 42", got)
+		}
+	})
+
+	t.Run("heterogeneous slice", func(t *testing.T) {
+		values := []Value[any]{ToAny(New(1)), ToAny(New("two")), ToAny(New(3.0))}
+
+		if got := FromAny[string](values[1]).Get(); got != "two" {
+			t.Errorf("FromAny[string](values[1]).Get() = %v, want 'two'", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		erased := ToAny(NewLazy(func() int {
+			called = true
+			return 1
+		}))
+		FromAny[int](erased)
+
+		if called {
+			t.Error("Source should not be forced during ToAny or FromAny")
+		}
+	})
+
+	t.Run("mismatch panics on Get", func(t *testing.T) {
+		val := FromAny[string](ToAny(New(42)))
+
+		defer func() {
+			r := recover()
+			err, ok := r.(error)
+			if !ok {
+				t.Fatalf("recovered %v, want an error", r)
+			}
+			if !strings.Contains(err.Error(), "int") || !strings.Contains(err.Error(), "string") {
+				t.Errorf("error %q should name both types", err)
+			}
+		}()
+		val.Get()
+		t.Error("Get() should panic on a type mismatch")
+	})
+}
@@ -0,0 +1,25 @@
+package lazy
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func Add[T Number](a, b Value[T]) Value[T] {
+	return NewLazy(func() T {
+		return a.Get() + b.Get()
+	}).describedAs("Add", a, b)
+}
+
+func Sub[T Number](a, b Value[T]) Value[T] {
+	return NewLazy(func() T {
+		return a.Get() - b.Get()
+	}).describedAs("Sub", a, b)
+}
+
+func Mul[T Number](a, b Value[T]) Value[T] {
+	return NewLazy(func() T {
+		return a.Get() * b.Get()
+	}).describedAs("Mul", a, b)
+}
@@ -0,0 +1,51 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	t.Run("add sub mul", func(t *testing.T) {
+		if got := Add(New(2), New(3)).Get(); got != 5 {
+			t.Errorf("Add(2, 3).Get() = %v, want 5", got)
+		}
+		if got := Sub(New(2), New(3)).Get(); got != -1 {
+			t.Errorf("Sub(2, 3).Get() = %v, want -1", got)
+		}
+		if got := Mul(New(2.5), New(4.0)).Get(); got != 10 {
+			t.Errorf("Mul(2.5, 4).Get() = %v, want 10", got)
+		}
+	})
+
+	t.Run("expression is lazy", func(t *testing.T) {
+		forced := 0
+		a := NewLazy(func() int { forced++; return 1 })
+		b := NewLazy(func() int { forced++; return 2 })
+		c := NewLazy(func() int { forced++; return 4 })
+
+		expr := Mul(Add(a, b), c)
+		if forced != 0 {
+			t.Errorf("Forced %d values while building the expression, want 0", forced)
+		}
+		if got := expr.Get(); got != 12 {
+			t.Errorf("(a+b)*c = %v, want 12", got)
+		}
+		if forced != 3 {
+			t.Errorf("Forced %d values during Get, want 3", forced)
+		}
+	})
+
+	t.Run("named types", func(t *testing.T) {
+		type Celsius float64
+		if got := Add(New(Celsius(20)), New(Celsius(1.5))).Get(); got != 21.5 {
+			t.Errorf("Add(20, 1.5).Get() = %v, want 21.5", got)
+		}
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		expr := Mul(Add(New(1), New(2)), New(3))
+		if got := expr.Describe(); got != "Mul(Add(Value, Value), Value)" {
+			t.Errorf("Describe() = %q, want %q", got, "Mul(Add(Value, Value), Value)")
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type AtomicValue[T any] struct {
+	source  Value[T]
+	current atomic.Pointer[T]
+
+	requested atomic.Uint64
+	mu        sync.Mutex
+	stored    uint64
+}
+
+func NewAtomicValue[T any](initial Value[T]) *AtomicValue[T] {
+	a := &AtomicValue[T]{
+		source: initial,
+	}
+	value := initial.Get()
+	a.current.Store(&value)
+	return a
+}
+
+func (a *AtomicValue[T]) Load() T {
+	return *a.current.Load()
+}
+
+func (a *AtomicValue[T]) Refresh() {
+	generation := a.requested.Add(1)
+	go a.store(generation)
+}
+
+// store forces the source and swaps in the result unless a refresh requested
+// after this one has already landed. A panicking source leaves the previous
+// snapshot in place.
+func (a *AtomicValue[T]) store(generation uint64) {
+	defer func() { recover() }()
+	value := a.source.Get()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if generation < a.stored {
+		return
+	}
+	a.stored = generation
+	a.current.Store(&value)
+}
@@ -0,0 +1,129 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAtomicValue(t *testing.T) {
+	t.Run("load initial value", func(t *testing.T) {
+		a := NewAtomicValue(New(42))
+		if got := a.Load(); got != 42 {
+			t.Errorf("Load() = %v, want 42", got)
+		}
+	})
+
+	t.Run("refresh off the read path", func(t *testing.T) {
+		var version atomic.Int32
+		release := make(chan struct{})
+		source := NewLazy(func() int32 {
+			v := version.Add(1)
+			if v > 1 {
+				<-release
+			}
+			return v
+		})
+		a := NewAtomicValue(source)
+
+		a.Refresh()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					if got := a.Load(); got != 1 {
+						t.Errorf("Load() during refresh = %v, want 1", got)
+						return
+					}
+				}
+			}()
+		}
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Readers blocked while a refresh was in progress")
+		}
+
+		close(release)
+		deadline := time.Now().Add(5 * time.Second)
+		for a.Load() != 2 {
+			if time.Now().After(deadline) {
+				t.Fatal("Load() never observed the refreshed value")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("slow refresh does not roll back a newer one", func(t *testing.T) {
+		var version atomic.Int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+		returned := make(chan struct{})
+		source := NewLazy(func() int32 {
+			v := version.Add(1)
+			if v == 2 {
+				close(started)
+				<-release
+				defer close(returned)
+			}
+			return v
+		})
+		a := NewAtomicValue(source)
+
+		a.Refresh()
+		<-started
+		a.Refresh()
+		waitForLoad(t, a, 3)
+
+		close(release)
+		<-returned
+		time.Sleep(20 * time.Millisecond)
+		if got := a.Load(); got != 3 {
+			t.Errorf("Load() after the slow refresh finished = %v, want 3", got)
+		}
+	})
+
+	t.Run("panicking refresh keeps previous snapshot", func(t *testing.T) {
+		var version atomic.Int32
+		panicked := make(chan struct{})
+		source := NewLazy(func() int32 {
+			v := version.Add(1)
+			if v == 2 {
+				close(panicked)
+				panic("refresh failed")
+			}
+			return v
+		})
+		a := NewAtomicValue(source)
+
+		a.Refresh()
+		<-panicked
+		time.Sleep(20 * time.Millisecond)
+		if got := a.Load(); got != 1 {
+			t.Errorf("Load() after a panicking refresh = %v, want 1", got)
+		}
+
+		a.Refresh()
+		waitForLoad(t, a, 3)
+	})
+}
+
+func waitForLoad[T comparable](t *testing.T, a *AtomicValue[T], want T) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for a.Load() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("Load() never observed %v", want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,54 @@
+package lazy
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type loaderSetter interface {
+	setLoader(loader any) bool
+}
+
+func (l *Value[T]) setLoader(loader any) bool {
+	f, ok := loader.(func() T)
+	if !ok {
+		return false
+	}
+	*l = NewLazy(f)
+	return true
+}
+
+func Bind(dst any, loaders map[string]any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("lazy: Bind requires a non-nil pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		setter, ok := rv.Field(i).Addr().Interface().(loaderSetter)
+		if !ok {
+			continue
+		}
+		key := field.Name
+		if tag, ok := field.Tag.Lookup("lazy"); ok {
+			if tag == "-" {
+				continue
+			}
+			key = tag
+		}
+		loader, ok := loaders[key]
+		if !ok {
+			continue
+		}
+		if !setter.setLoader(loader) {
+			return fmt.Errorf("lazy: loader %q has type %T, which cannot populate field %s of type %s", key, loader, field.Name, field.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestBind(t *testing.T) {
+	t.Run("fields load on demand", func(t *testing.T) {
+		type Config struct {
+			Host Value[string] `lazy:"host"`
+			Port Value[int]
+		}
+
+		hostLoaded, portLoaded := false, false
+		var cfg Config
+		err := Bind(&cfg, map[string]any{
+			"host": func() string { hostLoaded = true; return "localhost" },
+			"Port": func() int { portLoaded = true; return 8080 },
+		})
+		if err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+
+		if hostLoaded || portLoaded {
+			t.Error("Loaders should not be called during Bind")
+		}
+		if got := cfg.Port.Get(); got != 8080 {
+			t.Errorf("cfg.Port.Get() = %v, want 8080", got)
+		}
+		if hostLoaded {
+			t.Error("Host loader should not be called when only Port is accessed")
+		}
+		if got := cfg.Host.Get(); got != "localhost" {
+			t.Errorf("cfg.Host.Get() = %v, want 'localhost'", got)
+		}
+	})
+
+	t.Run("skipped fields", func(t *testing.T) {
+		type Config struct {
+			Ignored Value[int] `lazy:"-"`
+			Missing Value[int]
+			Plain   int
+			hidden  Value[int]
+		}
+
+		cfg := Config{Ignored: New(1), Plain: 2}
+		err := Bind(&cfg, map[string]any{
+			"Ignored": func() int { return 10 },
+			"Plain":   func() int { return 20 },
+			"hidden":  func() int { return 30 },
+		})
+		if err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+
+		if got := cfg.Ignored.Get(); got != 1 {
+			t.Errorf("cfg.Ignored.Get() = %v, want 1", got)
+		}
+		if got := cfg.Missing.Get(); got != 0 {
+			t.Errorf("cfg.Missing.Get() = %v, want 0", got)
+		}
+		if cfg.Plain != 2 {
+			t.Errorf("cfg.Plain = %v, want 2", cfg.Plain)
+		}
+		if got := cfg.hidden.Get(); got != 0 {
+			t.Errorf("cfg.hidden.Get() = %v, want 0", got)
+		}
+	})
+
+	t.Run("mismatched loader type", func(t *testing.T) {
+		type Config struct {
+			Port Value[int]
+		}
+
+		var cfg Config
+		if err := Bind(&cfg, map[string]any{"Port": func() string { return "8080" }}); err == nil {
+			t.Error("Bind() with mismatched loader should return an error")
+		}
+	})
+
+	t.Run("invalid destination", func(t *testing.T) {
+		type Config struct {
+			Port Value[int]
+		}
+
+		var nilCfg *Config
+		for _, dst := range []any{Config{}, nilCfg, new(int), nil} {
+			if err := Bind(dst, nil); err == nil {
+				t.Errorf("Bind(%T) should return an error", dst)
+			}
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package lazy
+
+func Broadcast[T any](v Value[T], consumers ...func(T)) Value[T] {
+	return NewLazy(func() T {
+		value := v.Get()
+		for _, consumer := range consumers {
+			consumer(value)
+		}
+		return value
+	}).describedAs("Broadcast", v)
+}
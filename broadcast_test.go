@@ -0,0 +1,50 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestBroadcast(t *testing.T) {
+	t.Run("single force for all consumers", func(t *testing.T) {
+		callCount := 0
+		source := NewLazy(func() int {
+			callCount++
+			return callCount * 10
+		})
+
+		var received []int
+		var order []string
+		val := Broadcast(source,
+			func(x int) {
+				received = append(received, x)
+				order = append(order, "first")
+			},
+			func(x int) {
+				received = append(received, x)
+				order = append(order, "second")
+			},
+		)
+
+		if callCount != 0 {
+			t.Error("Source should not be forced during Broadcast")
+		}
+		if got := val.Get(); got != 10 {
+			t.Errorf("Get() = %v, want 10", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+		if len(received) != 2 || received[0] != 10 || received[1] != 10 {
+			t.Errorf("Consumers received %v, want [10 10]", received)
+		}
+		if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+			t.Errorf("Consumers called in order %v, want [first second]", order)
+		}
+	})
+
+	t.Run("no consumers", func(t *testing.T) {
+		if got := Broadcast(New(5)).Get(); got != 5 {
+			t.Errorf("Broadcast(5).Get() = %v, want 5", got)
+		}
+	})
+}
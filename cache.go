@@ -0,0 +1,41 @@
+package lazy
+
+import (
+	"sync"
+)
+
+type Cache interface {
+	Get(key any) (any, bool)
+	Set(key any, value any)
+}
+
+type MemoryCache struct {
+	entries sync.Map
+}
+
+func (c *MemoryCache) Get(key any) (any, bool) {
+	return c.entries.Load(key)
+}
+
+func (c *MemoryCache) Set(key any, value any) {
+	c.entries.Store(key, value)
+}
+
+func MemoizedWithCache[A comparable, R any](f func(A) R, cache Cache) func(A) R {
+	return func(arg A) R {
+		if cached, ok := cache.Get(arg); ok {
+			// A nil result of interface type, such as a nil error, is
+			// stored as a nil any and fails the type assertion below.
+			if cached == nil && any(*new(R)) == nil {
+				var zero R
+				return zero
+			}
+			if result, ok := cached.(R); ok {
+				return result
+			}
+		}
+		result := f(arg)
+		cache.Set(arg, result)
+		return result
+	}
+}
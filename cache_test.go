@@ -0,0 +1,133 @@
+package lazy
+
+import (
+	"testing"
+)
+
+type mockCache struct {
+	entries map[any]any
+	gets    []any
+	sets    []any
+}
+
+func (c *mockCache) Get(key any) (any, bool) {
+	c.gets = append(c.gets, key)
+	value, ok := c.entries[key]
+	return value, ok
+}
+
+func (c *mockCache) Set(key any, value any) {
+	c.sets = append(c.sets, key)
+	c.entries[key] = value
+}
+
+func TestMemoizedWithCache(t *testing.T) {
+	t.Run("mock backend interactions", func(t *testing.T) {
+		cache := &mockCache{entries: map[any]any{}}
+		callCount := map[int]int{}
+		square := MemoizedWithCache(func(x int) int {
+			callCount[x]++
+			return x * x
+		}, cache)
+
+		if got := square(3); got != 9 {
+			t.Errorf("square(3) = %v, want 9", got)
+		}
+		if got := square(3); got != 9 {
+			t.Errorf("Second square(3) = %v, want 9", got)
+		}
+		if got := square(4); got != 16 {
+			t.Errorf("square(4) = %v, want 16", got)
+		}
+
+		if callCount[3] != 1 || callCount[4] != 1 {
+			t.Errorf("Function calls per key = %v, want map[3:1 4:1]", callCount)
+		}
+		if len(cache.gets) != 3 {
+			t.Errorf("Cache Get called %d times, want 3", len(cache.gets))
+		}
+		if len(cache.sets) != 2 || cache.sets[0] != 3 || cache.sets[1] != 4 {
+			t.Errorf("Cache Set keys = %v, want [3 4]", cache.sets)
+		}
+	})
+
+	t.Run("unexpected cached type is recomputed", func(t *testing.T) {
+		cache := &mockCache{entries: map[any]any{"k": 123}}
+		upper := MemoizedWithCache(func(s string) string {
+			return s + "!"
+		}, cache)
+
+		if got := upper("k"); got != "k!" {
+			t.Errorf("upper(k) = %v, want 'k!'", got)
+		}
+		if cache.entries["k"] != "k!" {
+			t.Errorf("Cached entry = %v, want 'k!'", cache.entries["k"])
+		}
+	})
+
+	t.Run("nil interface result is a hit", func(t *testing.T) {
+		cache := &mockCache{entries: map[any]any{}}
+		callCount := 0
+		validate := MemoizedWithCache(func(s string) error {
+			callCount++
+			return nil
+		}, cache)
+
+		for i := 0; i < 3; i++ {
+			if err := validate("ok"); err != nil {
+				t.Errorf("validate(ok) = %v, want nil", err)
+			}
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+		if len(cache.sets) != 1 {
+			t.Errorf("Cache sets = %d, want 1", len(cache.sets))
+		}
+	})
+
+	t.Run("nil entry for a concrete type is recomputed", func(t *testing.T) {
+		cache := &MemoryCache{}
+		cache.Set(3, nil)
+		callCount := 0
+		square := MemoizedWithCache(func(x int) int {
+			callCount++
+			return x * x
+		}, cache)
+
+		if got := square(3); got != 9 {
+			t.Errorf("square(3) = %v, want 9", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("memory cache", func(t *testing.T) {
+		callCount := 0
+		length := MemoizedWithCache(func(s string) int {
+			callCount++
+			return len(s)
+		}, &MemoryCache{})
+
+		length("hello")
+		if got := length("hello"); got != 5 {
+			t.Errorf("length(hello) = %v, want 5", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	var cache MemoryCache
+
+	if _, ok := cache.Get("missing"); ok {
+		t.Error("Get(missing) should report false")
+	}
+	cache.Set("key", 42)
+	if got, ok := cache.Get("key"); !ok || got != 42 {
+		t.Errorf("Get(key) = %v, %v, want 42, true", got, ok)
+	}
+}
@@ -0,0 +1,7 @@
+package lazy
+
+func CacheWithValidator[T any](f func() T, isStale func() bool) Value[T] {
+	return MemoizeUntil(f, func(T) bool {
+		return isStale()
+	}).describedAs("CacheWithValidator")
+}
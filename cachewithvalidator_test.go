@@ -0,0 +1,54 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestCacheWithValidator(t *testing.T) {
+	t.Run("recomputes only when stale", func(t *testing.T) {
+		callCount := 0
+		stale := false
+		val := CacheWithValidator(func() int {
+			callCount++
+			return callCount
+		}, func() bool {
+			return stale
+		})
+
+		if callCount != 0 {
+			t.Error("Function should not be called during CacheWithValidator")
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("First Get() = %v, want 1", got)
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("Second Get() = %v, want cached 1", got)
+		}
+
+		stale = true
+		if got := val.Get(); got != 2 {
+			t.Errorf("Get() when stale = %v, want 2", got)
+		}
+
+		stale = false
+		if got := val.Get(); got != 2 {
+			t.Errorf("Get() when fresh = %v, want cached 2", got)
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times, want 2", callCount)
+		}
+	})
+
+	t.Run("validator not checked before first compute", func(t *testing.T) {
+		checks := 0
+		val := CacheWithValidator(func() int { return 1 }, func() bool {
+			checks++
+			return true
+		})
+
+		val.Get()
+		if checks != 0 {
+			t.Errorf("Validator checked %d times on first Get, want 0", checks)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package lazy
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io"
+)
+
+type Codec[T any] interface {
+	Encode(w io.Writer, value T) error
+	Decode(r io.Reader) (T, error)
+}
+
+type GobCodec[T any] struct{}
+
+func (GobCodec[T]) Encode(w io.Writer, value T) error {
+	return gob.NewEncoder(w).Encode(value)
+}
+
+func (GobCodec[T]) Decode(r io.Reader) (T, error) {
+	var value T
+	err := gob.NewDecoder(r).Decode(&value)
+	return value, err
+}
+
+type JSONCodec[T any] struct{}
+
+func (JSONCodec[T]) Encode(w io.Writer, value T) error {
+	return json.NewEncoder(w).Encode(value)
+}
+
+func (JSONCodec[T]) Decode(r io.Reader) (T, error) {
+	var value T
+	err := json.NewDecoder(r).Decode(&value)
+	return value, err
+}
@@ -0,0 +1,57 @@
+package lazy
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type codecRecord struct {
+	Name  string
+	Count int
+	Tags  []string
+}
+
+func TestCodecs(t *testing.T) {
+	record := codecRecord{Name: "Alice", Count: 3, Tags: []string{"a", "b"}}
+	codecs := []struct {
+		name  string
+		codec Codec[codecRecord]
+	}{
+		{"gob", GobCodec[codecRecord]{}},
+		{"json", JSONCodec[codecRecord]{}},
+	}
+
+	for _, tt := range codecs {
+		t.Run(tt.name+" round trip", func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.codec.Encode(&buf, record); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			got, err := tt.codec.Decode(&buf)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, record) {
+				t.Errorf("Decode() = %+v, want %+v", got, record)
+			}
+		})
+
+		t.Run(tt.name+" decode error", func(t *testing.T) {
+			if _, err := tt.codec.Decode(bytes.NewBufferString("garbage")); err == nil {
+				t.Error("Decode() of garbage should return an error")
+			}
+		})
+
+		t.Run(tt.name+" with NewLazyPersistent", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "record")
+			NewLazyPersistent(path, func() codecRecord { return record }, tt.codec).Get()
+
+			loaded := NewLazyPersistent(path, func() codecRecord { return codecRecord{} }, tt.codec)
+			if got := loaded.Get(); !reflect.DeepEqual(got, record) {
+				t.Errorf("Loaded Get() = %+v, want %+v", got, record)
+			}
+		})
+	}
+}
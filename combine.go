@@ -0,0 +1,43 @@
+package lazy
+
+import (
+	"slices"
+	"strings"
+)
+
+type anyValue interface {
+	getAny() any
+	writeDesc(b *strings.Builder)
+}
+
+func (l Value[T]) getAny() any {
+	return l.Get()
+}
+
+type Combiner[R any] struct {
+	values []anyValue
+}
+
+func Combine[R any]() Combiner[R] {
+	return Combiner[R]{}
+}
+
+func With[R any, T any](c Combiner[R], v Value[T]) Combiner[R] {
+	return Combiner[R]{
+		values: append(slices.Clip(c.values), v),
+	}
+}
+
+func (c Combiner[R]) Build(f func(args []any) R) Value[R] {
+	sources := make([]describer, len(c.values))
+	for i, v := range c.values {
+		sources[i] = v
+	}
+	return NewLazy(func() R {
+		args := make([]any, len(c.values))
+		for i, v := range c.values {
+			args[i] = v.getAny()
+		}
+		return f(args)
+	}).describedAs("Combine", sources...)
+}
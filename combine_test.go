@@ -0,0 +1,86 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	t.Run("four differently typed values", func(t *testing.T) {
+		type Profile struct {
+			Name   string
+			Age    int
+			Active bool
+			Score  float64
+		}
+
+		forced := 0
+		name := NewLazy(func() string { forced++; return "Alice" })
+		age := NewLazy(func() int { forced++; return 30 })
+		active := New(true)
+		score := Map(New(4.0), func(x float64) float64 { return x / 2 })
+
+		c := Combine[Profile]()
+		c = With(c, name)
+		c = With(c, age)
+		c = With(c, active)
+		c = With(c, score)
+		profile := c.Build(func(args []any) Profile {
+			return Profile{
+				Name:   args[0].(string),
+				Age:    args[1].(int),
+				Active: args[2].(bool),
+				Score:  args[3].(float64),
+			}
+		})
+
+		if forced != 0 {
+			t.Error("Values should not be forced before Get")
+		}
+		want := Profile{Name: "Alice", Age: 30, Active: true, Score: 2}
+		if got := profile.Get(); got != want {
+			t.Errorf("Build().Get() = %+v, want %+v", got, want)
+		}
+		if forced != 2 {
+			t.Errorf("Forced %d lazy values, want 2", forced)
+		}
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		val := Combine[int]().Build(func(args []any) int {
+			return len(args)
+		})
+		if got := val.Get(); got != 0 {
+			t.Errorf("Build().Get() = %v, want 0", got)
+		}
+	})
+
+	t.Run("branches do not share values", func(t *testing.T) {
+		base := With(Combine[int](), New(1))
+		left := With(base, New(2))
+		right := With(base, New(3))
+		sum := func(args []any) int {
+			total := 0
+			for _, arg := range args {
+				total += arg.(int)
+			}
+			return total
+		}
+
+		if got := left.Build(sum).Get(); got != 3 {
+			t.Errorf("left Build().Get() = %v, want 3", got)
+		}
+		if got := right.Build(sum).Get(); got != 4 {
+			t.Errorf("right Build().Get() = %v, want 4", got)
+		}
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		c := With(With(Combine[int](), New(1)), NewLazy(func() string { return "" }))
+		val := c.Build(func(args []any) int {
+			return 0
+		})
+		if got := val.Describe(); got != "Combine(Value, Lazy)" {
+			t.Errorf("Describe() = %q, want %q", got, "Combine(Value, Lazy)")
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+func ContextMemoize[T any, K comparable](f func(context.Context) T, keyOf func(context.Context) K) func(context.Context) T {
+	var (
+		mu    sync.Mutex
+		cache = map[K]func() T{}
+	)
+	return func(ctx context.Context) T {
+		key := keyOf(ctx)
+		mu.Lock()
+		get, ok := cache[key]
+		if !ok {
+			get = sync.OnceValue(func() T {
+				return f(ctx)
+			})
+			cache[key] = get
+		}
+		mu.Unlock()
+		return get()
+	}
+}
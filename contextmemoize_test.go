@@ -0,0 +1,61 @@
+package lazy
+
+import (
+	"context"
+	"testing"
+)
+
+type requestIDKey struct{}
+
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func TestContextMemoize(t *testing.T) {
+	t.Run("caches per context key", func(t *testing.T) {
+		callCount := 0
+		user := ContextMemoize(func(ctx context.Context) string {
+			callCount++
+			return "user-for-" + requestID(ctx)
+		}, requestID)
+
+		first := context.WithValue(context.Background(), requestIDKey{}, "r1")
+		second := context.WithValue(context.Background(), requestIDKey{}, "r2")
+
+		if got := user(first); got != "user-for-r1" {
+			t.Errorf("user(r1) = %v, want 'user-for-r1'", got)
+		}
+		if got := user(first); got != "user-for-r1" {
+			t.Errorf("Second user(r1) = %v, want 'user-for-r1'", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times for one context, want 1", callCount)
+		}
+
+		if got := user(second); got != "user-for-r2" {
+			t.Errorf("user(r2) = %v, want 'user-for-r2'", got)
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times for two contexts, want 2", callCount)
+		}
+	})
+
+	t.Run("derived context with same key reuses cache", func(t *testing.T) {
+		callCount := 0
+		load := ContextMemoize(func(ctx context.Context) int {
+			callCount++
+			return 1
+		}, requestID)
+
+		parent := context.WithValue(context.Background(), requestIDKey{}, "r1")
+		child, cancel := context.WithCancel(parent)
+		defer cancel()
+
+		load(parent)
+		load(child)
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package lazy
+
+func FallbackChain[T any](primary Value[T], fallbacks ...Value[T]) Value[T] {
+	sources := []describer{primary}
+	for _, fallback := range fallbacks {
+		sources = append(sources, fallback)
+	}
+	return NewLazy(func() T {
+		result, recovered, ok := tryGet(primary)
+		for _, fallback := range fallbacks {
+			if ok {
+				return result
+			}
+			result, recovered, ok = tryGet(fallback)
+		}
+		if !ok {
+			panic(recovered)
+		}
+		return result
+	}).describedAs("FallbackChain", sources...)
+}
+
+func tryGet[T any](v Value[T]) (result T, recovered any, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			recovered = r
+		}
+	}()
+	return v.Get(), nil, true
+}
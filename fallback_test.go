@@ -0,0 +1,65 @@
+package lazy
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFallbackChain(t *testing.T) {
+	t.Run("primary succeeds", func(t *testing.T) {
+		fallbackCalled := false
+		val := FallbackChain(New(1), NewLazy(func() int {
+			fallbackCalled = true
+			return 2
+		}))
+
+		if got := val.Get(); got != 1 {
+			t.Errorf("FallbackChain(1, 2).Get() = %v, want 1", got)
+		}
+		if fallbackCalled {
+			t.Error("Fallback should not be forced when primary succeeds")
+		}
+	})
+
+	t.Run("falls through to second fallback", func(t *testing.T) {
+		primary := NewLazy(func() int {
+			panic("primary failed")
+		})
+		first := NewLazy(func() int {
+			panic(errors.New("first fallback failed"))
+		})
+		second := New(3)
+
+		if got := FallbackChain(primary, first, second).Get(); got != 3 {
+			t.Errorf("FallbackChain(panic, error, 3).Get() = %v, want 3", got)
+		}
+	})
+
+	t.Run("chain is lazy", func(t *testing.T) {
+		called := false
+		FallbackChain(NewLazy(func() int {
+			called = true
+			return 1
+		}))
+
+		if called {
+			t.Error("Primary should not be forced during FallbackChain")
+		}
+	})
+
+	t.Run("all fail re-panics with last failure", func(t *testing.T) {
+		last := errors.New("last")
+		val := FallbackChain(
+			NewLazy(func() int { panic("first") }),
+			NewLazy(func() int { panic(last) }),
+		)
+
+		defer func() {
+			if r := recover(); r != last {
+				t.Errorf("recovered %v, want %v", r, last)
+			}
+		}()
+		val.Get()
+		t.Error("Get() should panic when every value fails")
+	})
+}
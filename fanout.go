@@ -0,0 +1,11 @@
+package lazy
+
+func FanOut[T any](v Value[T], chans ...chan<- T) Value[T] {
+	return NewLazy(func() T {
+		value := v.Get()
+		for _, ch := range chans {
+			ch <- value
+		}
+		return value
+	}).describedAs("FanOut", v)
+}
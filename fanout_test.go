@@ -0,0 +1,64 @@
+package lazy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFanOut(t *testing.T) {
+	t.Run("each channel receives once", func(t *testing.T) {
+		callCount := 0
+		source := NewLazy(func() int {
+			callCount++
+			return 42
+		})
+		a, b := make(chan int, 1), make(chan int, 1)
+		val := FanOut(source, a, b)
+
+		if callCount != 0 || len(a) != 0 || len(b) != 0 {
+			t.Error("FanOut should not force or send before Get")
+		}
+		if got := val.Get(); got != 42 {
+			t.Errorf("Get() = %v, want 42", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+		for name, ch := range map[string]chan int{"a": a, "b": b} {
+			if len(ch) != 1 {
+				t.Fatalf("Channel %s holds %d values, want 1", name, len(ch))
+			}
+			if got := <-ch; got != 42 {
+				t.Errorf("Channel %s received %v, want 42", name, got)
+			}
+		}
+	})
+
+	t.Run("blocks on a full channel", func(t *testing.T) {
+		full := make(chan int, 1)
+		full <- 0
+		val := FanOut(New(1), full)
+
+		done := make(chan struct{})
+		go func() {
+			val.Get()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("Get() should block while the channel is full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		<-full
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Get() did not unblock after the channel was drained")
+		}
+		if got := <-full; got != 1 {
+			t.Errorf("Channel received %v, want 1", got)
+		}
+	})
+}
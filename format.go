@@ -0,0 +1,17 @@
+package lazy
+
+import "fmt"
+
+func Format(format string, vs ...Value[any]) Value[string] {
+	sources := make([]describer, len(vs))
+	for i, v := range vs {
+		sources[i] = v
+	}
+	return NewLazy(func() string {
+		args := make([]any, len(vs))
+		for i, v := range vs {
+			args[i] = v.Get()
+		}
+		return fmt.Sprintf(format, args...)
+	}).describedAs("Format", sources...)
+}
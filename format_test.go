@@ -0,0 +1,30 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("two lazy args", func(t *testing.T) {
+		forced := 0
+		user := NewLazy(func() any { forced++; return "alice" })
+		count := ToAny(NewLazy(func() int { forced++; return 3 }))
+
+		msg := Format("%s has %d items", user, count)
+		if forced != 0 {
+			t.Errorf("Forced %d args during Format, want 0", forced)
+		}
+		if got := msg.Get(); got != "alice has 3 items" {
+			t.Errorf("Format().Get() = %q, want %q", got, "alice has 3 items")
+		}
+		if forced != 2 {
+			t.Errorf("Forced %d args during Get, want 2", forced)
+		}
+	})
+
+	t.Run("no args", func(t *testing.T) {
+		if got := Format("plain").Get(); got != "plain" {
+			t.Errorf("Format(plain).Get() = %q, want %q", got, "plain")
+		}
+	})
+}
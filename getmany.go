@@ -0,0 +1,15 @@
+package lazy
+
+func GetMany[T any](vs []Value[T]) ([]T, []int) {
+	results := make([]T, len(vs))
+	var failed []int
+	for i, v := range vs {
+		result, _, ok := tryGet(v)
+		if !ok {
+			failed = append(failed, i)
+			continue
+		}
+		results[i] = result
+	}
+	return results, failed
+}
@@ -0,0 +1,47 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestGetMany(t *testing.T) {
+	t.Run("all succeed", func(t *testing.T) {
+		results, failed := GetMany([]Value[int]{New(1), NewLazy(func() int { return 2 }), New(3)})
+
+		if len(failed) != 0 {
+			t.Errorf("GetMany failed indices = %v, want none", failed)
+		}
+		if len(results) != 3 || results[0] != 1 || results[1] != 2 || results[2] != 3 {
+			t.Errorf("GetMany results = %v, want [1 2 3]", results)
+		}
+	})
+
+	t.Run("one panics", func(t *testing.T) {
+		forced := 0
+		vs := []Value[int]{
+			NewLazy(func() int { forced++; return 1 }),
+			NewLazy(func() int { panic("bad item") }),
+			NewLazy(func() int { forced++; return 3 }),
+		}
+
+		results, failed := GetMany(vs)
+
+		if forced != 2 {
+			t.Errorf("Forced %d healthy values, want 2", forced)
+		}
+		if len(failed) != 1 || failed[0] != 1 {
+			t.Errorf("GetMany failed indices = %v, want [1]", failed)
+		}
+		if results[0] != 1 || results[1] != 0 || results[2] != 3 {
+			t.Errorf("GetMany results = %v, want [1 0 3]", results)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		results, failed := GetMany[int](nil)
+
+		if len(results) != 0 || len(failed) != 0 {
+			t.Errorf("GetMany(nil) = %v, %v, want empty", results, failed)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package lazy
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+func GoroutineLocal[T any](f func() T) Value[T] {
+	var (
+		mu    sync.Mutex
+		cache = map[uint64]T{}
+	)
+	return NewLazy(func() T {
+		id := goroutineID()
+		mu.Lock()
+		value, ok := cache[id]
+		mu.Unlock()
+		if ok {
+			return value
+		}
+		value = f()
+		mu.Lock()
+		cache[id] = value
+		mu.Unlock()
+		return value
+	}).describedAs("GoroutineLocal")
+}
+
+func goroutineID() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	fields := bytes.Fields(buf[:n])
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseUint(string(fields[1]), 10, 64)
+	return id
+}
@@ -0,0 +1,67 @@
+package lazy
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGoroutineLocal(t *testing.T) {
+	t.Run("same goroutine reuses instance", func(t *testing.T) {
+		callCount := 0
+		val := GoroutineLocal(func() *int {
+			callCount++
+			n := callCount
+			return &n
+		})
+
+		first := val.Get()
+		second := val.Get()
+		if first != second {
+			t.Error("Get() on the same goroutine should return the same instance")
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("each goroutine gets its own instance", func(t *testing.T) {
+		var mu sync.Mutex
+		callCount := 0
+		val := GoroutineLocal(func() *int {
+			mu.Lock()
+			defer mu.Unlock()
+			callCount++
+			n := callCount
+			return &n
+		})
+
+		results := make([][2]*int, 2)
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = [2]*int{val.Get(), val.Get()}
+			}()
+		}
+		wg.Wait()
+
+		for i, r := range results {
+			if r[0] != r[1] {
+				t.Errorf("Goroutine %d got different instances from repeated Gets", i)
+			}
+		}
+		if results[0][0] == results[1][0] {
+			t.Error("Different goroutines should get different instances")
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times, want 2", callCount)
+		}
+	})
+
+	t.Run("goroutine id", func(t *testing.T) {
+		if goroutineID() == 0 {
+			t.Error("goroutineID() should not be 0")
+		}
+	})
+}
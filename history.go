@@ -0,0 +1,38 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func WithHistory[T any](v Value[T], size int) (Value[T], func() []T) {
+	var (
+		mu     sync.Mutex
+		buffer = make([]T, 0, max(size, 0))
+		next   int
+	)
+	tracked := NewLazy(func() T {
+		value := v.Get()
+		if size <= 0 {
+			return value
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if len(buffer) < size {
+			buffer = append(buffer, value)
+		} else {
+			buffer[next] = value
+		}
+		next = (next + 1) % size
+		return value
+	}).describedAs("WithHistory", v)
+
+	history := func() []T {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(buffer) < size {
+			return append([]T(nil), buffer...)
+		}
+		return append(append([]T(nil), buffer[next:]...), buffer[:next]...)
+	}
+	return tracked, history
+}
@@ -0,0 +1,58 @@
+package lazy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithHistory(t *testing.T) {
+	t.Run("keeps last results", func(t *testing.T) {
+		counter := 0
+		source := NewLazy(func() int {
+			counter++
+			return counter
+		})
+		val, history := WithHistory(source, 3)
+
+		for i := 0; i < 5; i++ {
+			val.Get()
+		}
+		if got := history(); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+			t.Errorf("history() = %v, want [3 4 5]", got)
+		}
+	})
+
+	t.Run("partially filled", func(t *testing.T) {
+		val, history := WithHistory(New(7), 3)
+
+		if got := history(); len(got) != 0 {
+			t.Errorf("history() before Get = %v, want empty", got)
+		}
+		val.Get()
+		val.Get()
+		if got := history(); !reflect.DeepEqual(got, []int{7, 7}) {
+			t.Errorf("history() = %v, want [7 7]", got)
+		}
+	})
+
+	t.Run("history is a copy", func(t *testing.T) {
+		val, history := WithHistory(New(1), 2)
+		val.Get()
+
+		history()[0] = 100
+		if got := history(); got[0] != 1 {
+			t.Errorf("history()[0] = %v after mutating a previous result, want 1", got[0])
+		}
+	})
+
+	t.Run("non-positive size keeps nothing", func(t *testing.T) {
+		val, history := WithHistory(New(1), 0)
+
+		if got := val.Get(); got != 1 {
+			t.Errorf("Get() = %v, want 1", got)
+		}
+		if got := history(); len(got) != 0 {
+			t.Errorf("history() = %v, want empty", got)
+		}
+	})
+}
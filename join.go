@@ -0,0 +1,19 @@
+package lazy
+
+import (
+	"strings"
+)
+
+func JoinStrings(sep string, vs ...Value[string]) Value[string] {
+	sources := make([]describer, len(vs))
+	for i, v := range vs {
+		sources[i] = v
+	}
+	return NewLazy(func() string {
+		parts := make([]string, len(vs))
+		for i, v := range vs {
+			parts[i] = v.Get()
+		}
+		return strings.Join(parts, sep)
+	}).describedAs("JoinStrings", sources...)
+}
@@ -0,0 +1,40 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestJoinStrings(t *testing.T) {
+	t.Run("three lazy strings", func(t *testing.T) {
+		forced := 0
+		part := func(s string) Value[string] {
+			return NewLazy(func() string {
+				forced++
+				return s
+			})
+		}
+
+		path := JoinStrings("/", part("usr"), part("local"), part("bin"))
+		if forced != 0 {
+			t.Errorf("Forced %d values during JoinStrings, want 0", forced)
+		}
+		if got := path.Get(); got != "usr/local/bin" {
+			t.Errorf("JoinStrings().Get() = %q, want %q", got, "usr/local/bin")
+		}
+		if forced != 3 {
+			t.Errorf("Forced %d values during Get, want 3", forced)
+		}
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		if got := JoinStrings(", ").Get(); got != "" {
+			t.Errorf("JoinStrings().Get() = %q, want empty string", got)
+		}
+	})
+
+	t.Run("one value", func(t *testing.T) {
+		if got := JoinStrings(", ", New("only")).Get(); got != "only" {
+			t.Errorf("JoinStrings(only).Get() = %q, want %q", got, "only")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func LimitEvals[T any](v Value[T], maxEvals int) Value[T] {
+	maxEvals = max(maxEvals, 1)
+	var (
+		mu    sync.Mutex
+		evals int
+		last  T
+	)
+	return NewLazy(func() T {
+		mu.Lock()
+		defer mu.Unlock()
+		if evals < maxEvals {
+			last = v.Get()
+			evals++
+		}
+		return last
+	}).describedAs("LimitEvals", v)
+}
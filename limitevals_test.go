@@ -0,0 +1,54 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestLimitEvals(t *testing.T) {
+	t.Run("caches after max evals", func(t *testing.T) {
+		callCount := 0
+		source := NewLazy(func() int {
+			callCount++
+			return callCount * 10
+		})
+		limited := LimitEvals(source, 3)
+
+		want := []int{10, 20, 30, 30, 30, 30}
+		for i, w := range want {
+			if got := limited.Get(); got != w {
+				t.Errorf("Get() #%d = %v, want %v", i+1, got, w)
+			}
+		}
+		if callCount != 3 {
+			t.Errorf("Source called %d times, want 3", callCount)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		LimitEvals(NewLazy(func() int {
+			called = true
+			return 1
+		}), 2)
+
+		if called {
+			t.Error("Source should not be forced during LimitEvals")
+		}
+	})
+
+	t.Run("non-positive limit evaluates once", func(t *testing.T) {
+		callCount := 0
+		limited := LimitEvals(NewLazy(func() int {
+			callCount++
+			return callCount
+		}), 0)
+
+		limited.Get()
+		if got := limited.Get(); got != 1 {
+			t.Errorf("Second Get() = %v, want 1", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+	})
+}
@@ -2,6 +2,6 @@ package lazy
 
 func Map[T any, R any](v Value[T], f func(T) R) Value[R] {
 	return NewLazy(func() R {
-		return f(v.Get())
-	})
+		return applyWithPolicy(f, v.Get())
+	}).describedAs("Map", v)
 }
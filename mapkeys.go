@@ -0,0 +1,12 @@
+package lazy
+
+func MapKeys[K1 comparable, K2 comparable, V any](m Value[map[K1]V], f func(K1) K2) Value[map[K2]V] {
+	return NewLazy(func() map[K2]V {
+		source := m.Get()
+		result := make(map[K2]V, len(source))
+		for k, v := range source {
+			result[f(k)] = v
+		}
+		return result
+	}).describedAs("MapKeys", m)
+}
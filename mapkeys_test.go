@@ -0,0 +1,59 @@
+package lazy
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestMapKeys(t *testing.T) {
+	t.Run("rekey map", func(t *testing.T) {
+		val := MapKeys(New(map[string]int{"a": 1, "b": 2}), strings.ToUpper)
+
+		want := map[string]int{"A": 1, "B": 2}
+		if got := val.Get(); !maps.Equal(got, want) {
+			t.Errorf("MapKeys(upper).Get() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("change key type", func(t *testing.T) {
+		val := MapKeys(New(map[string]bool{"one": true, "three": false}), func(k string) int {
+			return len(k)
+		})
+
+		want := map[int]bool{3: true, 5: false}
+		if got := val.Get(); !maps.Equal(got, want) {
+			t.Errorf("MapKeys(len).Get() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("collision keeps one value", func(t *testing.T) {
+		val := MapKeys(New(map[string]int{"a": 1, "A": 2}), strings.ToLower)
+
+		got := val.Get()
+		if len(got) != 1 || (got["a"] != 1 && got["a"] != 2) {
+			t.Errorf("MapKeys(lower).Get() = %v, want a single key 'a' holding 1 or 2", got)
+		}
+	})
+
+	t.Run("source map is untouched", func(t *testing.T) {
+		source := map[string]int{"a": 1}
+		MapKeys(New(source), strings.ToUpper).Get()
+
+		if len(source) != 1 || source["a"] != 1 {
+			t.Errorf("Source map = %v after MapKeys, want map[a:1]", source)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		MapKeys(NewLazy(func() map[string]int {
+			called = true
+			return nil
+		}), strings.ToUpper)
+
+		if called {
+			t.Error("Source should not be forced during MapKeys")
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package lazy
+
+func Map2[A any, B any, R any](a Value[A], b Value[B], f func(A, B) R) Value[R] {
+	return NewLazy(func() R {
+		return f(a.Get(), b.Get())
+	}).describedAs("Map2", a, b)
+}
+
+func Map3[A any, B any, C any, R any](a Value[A], b Value[B], c Value[C], f func(A, B, C) R) Value[R] {
+	return NewLazy(func() R {
+		return f(a.Get(), b.Get(), c.Get())
+	}).describedAs("Map3", a, b, c)
+}
+
+func Map4[A any, B any, C any, D any, R any](a Value[A], b Value[B], c Value[C], d Value[D], f func(A, B, C, D) R) Value[R] {
+	return NewLazy(func() R {
+		return f(a.Get(), b.Get(), c.Get(), d.Get())
+	}).describedAs("Map4", a, b, c, d)
+}
+
+func Map5[A any, B any, C any, D any, E any, R any](a Value[A], b Value[B], c Value[C], d Value[D], e Value[E], f func(A, B, C, D, E) R) Value[R] {
+	return NewLazy(func() R {
+		return f(a.Get(), b.Get(), c.Get(), d.Get(), e.Get())
+	}).describedAs("Map5", a, b, c, d, e)
+}
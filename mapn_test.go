@@ -0,0 +1,73 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestMapN(t *testing.T) {
+	t.Run("map2", func(t *testing.T) {
+		val := Map2(New(2), New("ab"), func(n int, s string) int {
+			return n * len(s)
+		})
+		if got := val.Get(); got != 4 {
+			t.Errorf("Map2(2, 'ab').Get() = %v, want 4", got)
+		}
+	})
+
+	t.Run("map3", func(t *testing.T) {
+		val := Map3(New(1), New(2), New(3), func(a, b, c int) int {
+			return a + b + c
+		})
+		if got := val.Get(); got != 6 {
+			t.Errorf("Map3(1, 2, 3).Get() = %v, want 6", got)
+		}
+	})
+
+	t.Run("map4", func(t *testing.T) {
+		val := Map4(New(1), New(2), New(3), New(4), func(a, b, c, d int) int {
+			return a * b * c * d
+		})
+		if got := val.Get(); got != 24 {
+			t.Errorf("Map4(1, 2, 3, 4).Get() = %v, want 24", got)
+		}
+	})
+
+	t.Run("map5 assembles a struct lazily", func(t *testing.T) {
+		type Record struct {
+			Name   string
+			Age    int
+			Active bool
+			Score  float64
+			Tags   []string
+		}
+
+		forced := 0
+		name := NewLazy(func() string { forced++; return "Alice" })
+		age := NewLazy(func() int { forced++; return 30 })
+		active := NewLazy(func() bool { forced++; return true })
+		score := NewLazy(func() float64 { forced++; return 9.5 })
+		tags := NewLazy(func() []string { forced++; return []string{"admin"} })
+
+		record := Map5(name, age, active, score, tags, func(n string, a int, ac bool, s float64, tg []string) Record {
+			return Record{Name: n, Age: a, Active: ac, Score: s, Tags: tg}
+		})
+
+		if forced != 0 {
+			t.Errorf("Forced %d values before Get, want 0", forced)
+		}
+		got := record.Get()
+		if forced != 5 {
+			t.Errorf("Forced %d values during Get, want 5", forced)
+		}
+		if got.Name != "Alice" || got.Age != 30 || !got.Active || got.Score != 9.5 || len(got.Tags) != 1 || got.Tags[0] != "admin" {
+			t.Errorf("Map5(...).Get() = %+v, want {Alice 30 true 9.5 [admin]}", got)
+		}
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		val := Map2(New(1), NewLazy(func() int { return 2 }), func(a, b int) int { return a + b })
+		if got := val.Describe(); got != "Map2(Value, Lazy)" {
+			t.Errorf("Describe() = %q, want %q", got, "Map2(Value, Lazy)")
+		}
+	})
+}
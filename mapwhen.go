@@ -0,0 +1,11 @@
+package lazy
+
+func MapWhen[T any](v Value[T], cond func(T) bool, f func(T) T) Value[T] {
+	return NewLazy(func() T {
+		value := v.Get()
+		if !cond(value) {
+			return value
+		}
+		return f(value)
+	}).describedAs("MapWhen", v)
+}
@@ -0,0 +1,43 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestMapWhen(t *testing.T) {
+	isNegative := func(x int) bool { return x < 0 }
+	negate := func(x int) int { return -x }
+
+	t.Run("condition true", func(t *testing.T) {
+		if got := MapWhen(New(-5), isNegative, negate).Get(); got != 5 {
+			t.Errorf("MapWhen(-5, negative, negate).Get() = %v, want 5", got)
+		}
+	})
+
+	t.Run("condition false", func(t *testing.T) {
+		called := false
+		val := MapWhen(New(5), isNegative, func(x int) int {
+			called = true
+			return -x
+		})
+
+		if got := val.Get(); got != 5 {
+			t.Errorf("MapWhen(5, negative, negate).Get() = %v, want 5", got)
+		}
+		if called {
+			t.Error("Map function should not be called when condition is false")
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		MapWhen(New(1), func(x int) bool {
+			called = true
+			return true
+		}, negate)
+
+		if called {
+			t.Error("Condition should not be called during MapWhen")
+		}
+	})
+}
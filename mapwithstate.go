@@ -0,0 +1,20 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func MapWithState[T any, S any, R any](v Value[T], init S, f func(S, T) (S, R)) Value[R] {
+	var (
+		mu    sync.Mutex
+		state = init
+	)
+	return NewLazy(func() R {
+		value := v.Get()
+		mu.Lock()
+		defer mu.Unlock()
+		var result R
+		state, result = f(state, value)
+		return result
+	}).describedAs("MapWithState", v)
+}
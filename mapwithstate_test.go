@@ -0,0 +1,49 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestMapWithState(t *testing.T) {
+	t.Run("running sum over re-evaluating source", func(t *testing.T) {
+		counter := 0
+		source := NewLazy(func() int {
+			counter++
+			return counter
+		})
+		sums := MapWithState(source, 0, func(sum int, x int) (int, int) {
+			return sum + x, sum + x
+		})
+
+		want := []int{1, 3, 6}
+		for i, w := range want {
+			if got := sums.Get(); got != w {
+				t.Errorf("Get() #%d = %v, want %v", i+1, got, w)
+			}
+		}
+	})
+
+	t.Run("state and result types differ", func(t *testing.T) {
+		val := MapWithState(New("x"), []string{}, func(seen []string, s string) ([]string, int) {
+			seen = append(seen, s)
+			return seen, len(seen)
+		})
+
+		val.Get()
+		if got := val.Get(); got != 2 {
+			t.Errorf("Second Get() = %v, want 2", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		MapWithState(New(1), 0, func(s int, x int) (int, int) {
+			called = true
+			return s, x
+		})
+
+		if called {
+			t.Error("State function should not be called during MapWithState")
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func MemoizedBy[A any, R any](f func(A) R, keyOf func(A) string) func(A) R {
+	var (
+		mu    sync.Mutex
+		cache = map[string]func() R{}
+	)
+	return func(arg A) R {
+		key := keyOf(arg)
+		mu.Lock()
+		get, ok := cache[key]
+		if !ok {
+			get = sync.OnceValue(func() R {
+				return f(arg)
+			})
+			cache[key] = get
+		}
+		mu.Unlock()
+		return get()
+	}
+}
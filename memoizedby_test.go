@@ -0,0 +1,67 @@
+package lazy
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoizedBy(t *testing.T) {
+	t.Run("slice argument", func(t *testing.T) {
+		callCount := 0
+		sum := MemoizedBy(func(xs []int) int {
+			callCount++
+			total := 0
+			for _, x := range xs {
+				total += x
+			}
+			return total
+		}, func(xs []int) string {
+			return fmt.Sprint(xs)
+		})
+
+		if got := sum([]int{1, 2, 3}); got != 6 {
+			t.Errorf("sum([1 2 3]) = %v, want 6", got)
+		}
+		if got := sum([]int{1, 2, 3}); got != 6 {
+			t.Errorf("Second sum([1 2 3]) = %v, want 6", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times for one key, want 1", callCount)
+		}
+
+		if got := sum([]int{4, 5}); got != 9 {
+			t.Errorf("sum([4 5]) = %v, want 9", got)
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times for two keys, want 2", callCount)
+		}
+	})
+
+	t.Run("concurrent calls compute once per key", func(t *testing.T) {
+		var callCount atomic.Int32
+		double := MemoizedBy(func(m map[string]int) int {
+			callCount.Add(1)
+			return m["x"] * 2
+		}, func(m map[string]int) string {
+			return fmt.Sprint(m["x"])
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if got := double(map[string]int{"x": 21}); got != 42 {
+					t.Errorf("double() = %v, want 42", got)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if got := callCount.Load(); got != 1 {
+			t.Errorf("Function called %d times, want 1", got)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func MemoizeUntil[T any](f func() T, invalid func(T) bool) Value[T] {
+	var (
+		mu     sync.Mutex
+		cached bool
+		value  T
+	)
+	return NewLazy(func() T {
+		mu.Lock()
+		defer mu.Unlock()
+		if !cached || invalid(value) {
+			value = f()
+			cached = true
+		}
+		return value
+	}).describedAs("MemoizeUntil")
+}
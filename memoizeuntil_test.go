@@ -0,0 +1,62 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestMemoizeUntil(t *testing.T) {
+	t.Run("recomputes when invalid", func(t *testing.T) {
+		type token struct {
+			id      int
+			expired bool
+		}
+
+		callCount := 0
+		var current *token
+		val := MemoizeUntil(func() *token {
+			callCount++
+			current = &token{id: callCount}
+			return current
+		}, func(tok *token) bool {
+			return tok.expired
+		})
+
+		if callCount != 0 {
+			t.Error("Function should not be called during MemoizeUntil")
+		}
+		if got := val.Get(); got.id != 1 {
+			t.Errorf("First Get().id = %v, want 1", got.id)
+		}
+		if got := val.Get(); got.id != 1 {
+			t.Errorf("Second Get().id = %v, want cached 1", got.id)
+		}
+
+		current.expired = true
+		if got := val.Get(); got.id != 2 {
+			t.Errorf("Get().id after expiry = %v, want 2", got.id)
+		}
+		if got := val.Get(); got.id != 2 {
+			t.Errorf("Get().id after recompute = %v, want cached 2", got.id)
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times, want 2", callCount)
+		}
+	})
+
+	t.Run("predicate not checked before first compute", func(t *testing.T) {
+		checks := 0
+		val := MemoizeUntil(func() int { return 1 }, func(int) bool {
+			checks++
+			return false
+		})
+
+		val.Get()
+		if checks != 0 {
+			t.Errorf("Predicate checked %d times on first Get, want 0", checks)
+		}
+		val.Get()
+		if checks != 1 {
+			t.Errorf("Predicate checked %d times on second Get, want 1", checks)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package lazy
+
+func MergeMaps[K comparable, V any](a, b Value[map[K]V], combine func(V, V) V) Value[map[K]V] {
+	return NewLazy(func() map[K]V {
+		left, right := a.Get(), b.Get()
+		result := make(map[K]V, len(left)+len(right))
+		for k, v := range left {
+			result[k] = v
+		}
+		for k, v := range right {
+			if existing, ok := result[k]; ok {
+				v = combine(existing, v)
+			}
+			result[k] = v
+		}
+		return result
+	}).describedAs("MergeMaps", a, b)
+}
@@ -0,0 +1,60 @@
+package lazy
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMergeMaps(t *testing.T) {
+	sum := func(x, y int) int { return x + y }
+
+	t.Run("overlapping keys are combined", func(t *testing.T) {
+		a := New(map[string]int{"a": 1, "b": 2})
+		b := New(map[string]int{"b": 10, "c": 3})
+
+		want := map[string]int{"a": 1, "b": 12, "c": 3}
+		if got := MergeMaps(a, b, sum).Get(); !maps.Equal(got, want) {
+			t.Errorf("MergeMaps().Get() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("combine receives a then b", func(t *testing.T) {
+		a := New(map[string]string{"k": "base"})
+		b := New(map[string]string{"k": "override"})
+		val := MergeMaps(a, b, func(x, y string) string {
+			return x + ">" + y
+		})
+
+		if got := val.Get()["k"]; got != "base>override" {
+			t.Errorf("MergeMaps().Get()[k] = %q, want %q", got, "base>override")
+		}
+	})
+
+	t.Run("nil maps are empty", func(t *testing.T) {
+		var empty Value[map[string]int]
+		b := New(map[string]int{"a": 1})
+
+		if got := MergeMaps(empty, b, sum).Get(); !maps.Equal(got, map[string]int{"a": 1}) {
+			t.Errorf("MergeMaps(nil, b).Get() = %v, want map[a:1]", got)
+		}
+		if got := MergeMaps(b, empty, sum).Get(); !maps.Equal(got, map[string]int{"a": 1}) {
+			t.Errorf("MergeMaps(b, nil).Get() = %v, want map[a:1]", got)
+		}
+		if got := MergeMaps(empty, empty, sum).Get(); got == nil || len(got) != 0 {
+			t.Errorf("MergeMaps(nil, nil).Get() = %v, want empty non-nil map", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		source := NewLazy(func() map[string]int {
+			called = true
+			return nil
+		})
+		MergeMaps(source, source, sum)
+
+		if called {
+			t.Error("Sources should not be forced during MergeMaps")
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package lazy
+
+func WithMiddleware[T any](v Value[T], mws ...func(func() T) func() T) Value[T] {
+	thunk := v.Get
+	for i := len(mws) - 1; i >= 0; i-- {
+		thunk = mws[i](thunk)
+	}
+	return NewLazy(thunk).describedAs("WithMiddleware", v)
+}
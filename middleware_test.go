@@ -0,0 +1,73 @@
+package lazy
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	t.Run("execution order", func(t *testing.T) {
+		var trace []string
+		record := func(name string) func(func() int) func() int {
+			return func(next func() int) func() int {
+				return func() int {
+					trace = append(trace, name+" before")
+					result := next()
+					trace = append(trace, name+" after")
+					return result
+				}
+			}
+		}
+		source := NewLazy(func() int {
+			trace = append(trace, "thunk")
+			return 1
+		})
+
+		val := WithMiddleware(source, record("outer"), record("inner"))
+		if len(trace) != 0 {
+			t.Errorf("Trace %v before Get, want empty", trace)
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("Get() = %v, want 1", got)
+		}
+
+		want := []string{"outer before", "inner before", "thunk", "inner after", "outer after"}
+		if !slices.Equal(trace, want) {
+			t.Errorf("Trace = %v, want %v", trace, want)
+		}
+	})
+
+	t.Run("middleware can change the result", func(t *testing.T) {
+		double := func(next func() int) func() int {
+			return func() int { return next() * 2 }
+		}
+
+		if got := WithMiddleware(New(3), double, double).Get(); got != 12 {
+			t.Errorf("Get() = %v, want 12", got)
+		}
+	})
+
+	t.Run("middleware can short-circuit", func(t *testing.T) {
+		called := false
+		source := NewLazy(func() int {
+			called = true
+			return 1
+		})
+		deny := func(next func() int) func() int {
+			return func() int { return -1 }
+		}
+
+		if got := WithMiddleware(source, deny).Get(); got != -1 {
+			t.Errorf("Get() = %v, want -1", got)
+		}
+		if called {
+			t.Error("Source should not be forced when middleware short-circuits")
+		}
+	})
+
+	t.Run("no middleware", func(t *testing.T) {
+		if got := WithMiddleware(New(5)).Get(); got != 5 {
+			t.Errorf("Get() = %v, want 5", got)
+		}
+	})
+}
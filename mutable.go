@@ -0,0 +1,30 @@
+package lazy
+
+import (
+	"sync"
+)
+
+type Mutable[T any] struct {
+	mu    sync.RWMutex
+	value T
+}
+
+func NewMutable[T any](initial T) *Mutable[T] {
+	return &Mutable[T]{
+		value: initial,
+	}
+}
+
+func (m *Mutable[T]) Update(f func(T) T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.value = f(m.value)
+}
+
+func (m *Mutable[T]) AsValue() Value[T] {
+	return NewLazy(func() T {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		return m.value
+	}).describedAs("Mutable")
+}
@@ -0,0 +1,54 @@
+package lazy
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMutable(t *testing.T) {
+	t.Run("view reflects updates", func(t *testing.T) {
+		m := NewMutable(1)
+		view := m.AsValue()
+
+		if got := view.Get(); got != 1 {
+			t.Errorf("Get() = %v, want 1", got)
+		}
+		m.Update(func(x int) int { return x + 10 })
+		if got := view.Get(); got != 11 {
+			t.Errorf("Get() after update = %v, want 11", got)
+		}
+	})
+
+	t.Run("derived values see latest state", func(t *testing.T) {
+		m := NewMutable("a")
+		length := Map(m.AsValue(), func(s string) int { return len(s) })
+
+		m.Update(func(s string) string { return s + "bc" })
+		if got := length.Get(); got != 3 {
+			t.Errorf("Map(view, len).Get() = %v, want 3", got)
+		}
+	})
+
+	t.Run("concurrent updates", func(t *testing.T) {
+		m := NewMutable(0)
+		view := m.AsValue()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				m.Update(func(x int) int { return x + 1 })
+			}()
+			go func() {
+				defer wg.Done()
+				view.Get()
+			}()
+		}
+		wg.Wait()
+
+		if got := view.Get(); got != 100 {
+			t.Errorf("Get() = %v, want 100", got)
+		}
+	})
+}
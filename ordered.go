@@ -0,0 +1,23 @@
+package lazy
+
+import (
+	"cmp"
+)
+
+func Min[T cmp.Ordered](a, b Value[T]) Value[T] {
+	return NewLazy(func() T {
+		return min(a.Get(), b.Get())
+	}).describedAs("Min", a, b)
+}
+
+func Max[T cmp.Ordered](a, b Value[T]) Value[T] {
+	return NewLazy(func() T {
+		return max(a.Get(), b.Get())
+	}).describedAs("Max", a, b)
+}
+
+func Clamp[T cmp.Ordered](v Value[T], lo, hi T) Value[T] {
+	return NewLazy(func() T {
+		return max(lo, min(v.Get(), hi))
+	}).describedAs("Clamp", v)
+}
@@ -0,0 +1,81 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		min, max int
+	}{
+		{"a smaller", 1, 2, 1, 2},
+		{"b smaller", 5, -3, -3, 5},
+		{"equal", 4, 4, 4, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewLazy(func() int { return tt.a })
+			b := NewLazy(func() int { return tt.b })
+
+			if got := Min(a, b).Get(); got != tt.min {
+				t.Errorf("Min(%v, %v).Get() = %v, want %v", tt.a, tt.b, got, tt.min)
+			}
+			if got := Max(a, b).Get(); got != tt.max {
+				t.Errorf("Max(%v, %v).Get() = %v, want %v", tt.a, tt.b, got, tt.max)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		if got := Min(New("b"), New("a")).Get(); got != "a" {
+			t.Errorf("Min(b, a).Get() = %v, want 'a'", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		source := NewLazy(func() int {
+			called = true
+			return 1
+		})
+		Min(source, source)
+		Max(source, source)
+		Clamp(source, 0, 1)
+
+		if called {
+			t.Error("Sources should not be forced during Min, Max or Clamp")
+		}
+	})
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name string
+		v    int
+		want int
+	}{
+		{"below", -5, 0},
+		{"lower bound", 0, 0},
+		{"inside", 5, 5},
+		{"upper bound", 10, 10},
+		{"above", 15, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val := Clamp(NewLazy(func() int { return tt.v }), 0, 10)
+			if got := val.Get(); got != tt.want {
+				t.Errorf("Clamp(%v, 0, 10).Get() = %v, want %v", tt.v, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("inverted bounds return lo", func(t *testing.T) {
+		if got := Clamp(New(5), 10, 0).Get(); got != 10 {
+			t.Errorf("Clamp(5, 10, 0).Get() = %v, want 10", got)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package lazy
+
+import (
+	"sync/atomic"
+)
+
+type PanicPolicy int32
+
+const (
+	PanicPropagate PanicPolicy = iota
+	PanicRecoverZero
+)
+
+var panicPolicy atomic.Int32
+
+func SetPanicPolicy(p PanicPolicy) {
+	panicPolicy.Store(int32(p))
+}
+
+func CurrentPanicPolicy() PanicPolicy {
+	return PanicPolicy(panicPolicy.Load())
+}
+
+func applyWithPolicy[T any, R any](f func(T) R, value T) (result R) {
+	if CurrentPanicPolicy() == PanicRecoverZero {
+		defer func() {
+			if r := recover(); r != nil {
+				var zero R
+				result = zero
+			}
+		}()
+	}
+	return f(value)
+}
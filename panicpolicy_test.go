@@ -0,0 +1,69 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestPanicPolicy(t *testing.T) {
+	defer SetPanicPolicy(PanicPropagate)
+
+	failing := func(x int) int {
+		panic("map failed")
+	}
+
+	t.Run("default propagates", func(t *testing.T) {
+		if got := CurrentPanicPolicy(); got != PanicPropagate {
+			t.Fatalf("CurrentPanicPolicy() = %v, want PanicPropagate", got)
+		}
+
+		defer func() {
+			if r := recover(); r != "map failed" {
+				t.Errorf("recovered %v, want 'map failed'", r)
+			}
+		}()
+		Map(New(1), failing).Get()
+		t.Error("Get() should panic under PanicPropagate")
+	})
+
+	t.Run("recover to zero", func(t *testing.T) {
+		SetPanicPolicy(PanicRecoverZero)
+		defer SetPanicPolicy(PanicPropagate)
+
+		if got := Map(New(1), failing).Get(); got != 0 {
+			t.Errorf("Map(1, panic).Get() = %v, want 0", got)
+		}
+		flatMapped := FlatMap(New(1), func(x int) Value[string] {
+			panic("flatmap failed")
+		})
+		if got := flatMapped.Get(); got != "" {
+			t.Errorf("FlatMap(1, panic).Get() = %q, want empty string", got)
+		}
+		if got := Map(New(1), func(x int) int { return x + 1 }).Get(); got != 2 {
+			t.Errorf("Map(1, x+1).Get() = %v, want 2", got)
+		}
+	})
+
+	t.Run("source panics are not recovered", func(t *testing.T) {
+		SetPanicPolicy(PanicRecoverZero)
+		defer SetPanicPolicy(PanicPropagate)
+
+		source := NewLazy(func() int { panic("source failed") })
+		defer func() {
+			if r := recover(); r != "source failed" {
+				t.Errorf("recovered %v, want 'source failed'", r)
+			}
+		}()
+		Map(source, func(x int) int { return x }).Get()
+		t.Error("Get() should panic when the source panics")
+	})
+
+	t.Run("policy is read at Get time", func(t *testing.T) {
+		val := Map(New(1), failing)
+
+		SetPanicPolicy(PanicRecoverZero)
+		defer SetPanicPolicy(PanicPropagate)
+		if got := val.Get(); got != 0 {
+			t.Errorf("Get() = %v, want 0", got)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package lazy
+
+import "os"
+
+func NewLazyPersistent[T any](path string, f func() T, codec Codec[T]) Value[T] {
+	return NewOnce(func() T {
+		if loaded, err := load(path, codec); err == nil {
+			return loaded
+		}
+		value := f()
+		_ = save(path, value, codec)
+		return value
+	})
+}
+
+func load[T any](path string, codec Codec[T]) (T, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return *new(T), err
+	}
+	defer file.Close()
+	return codec.Decode(file)
+}
+
+func save[T any](path string, value T, codec Codec[T]) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := codec.Encode(file, value); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,91 @@
+package lazy
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type intCodec struct{}
+
+func (intCodec) Encode(w io.Writer, value int) error {
+	_, err := fmt.Fprint(w, value)
+	return err
+}
+
+func (intCodec) Decode(r io.Reader) (int, error) {
+	var value int
+	_, err := fmt.Fscan(r, &value)
+	return value, err
+}
+
+func TestNewLazyPersistent(t *testing.T) {
+	t.Run("compute then load", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "value")
+		callCount := 0
+		compute := func() int {
+			callCount++
+			return 42
+		}
+
+		first := NewLazyPersistent(path, compute, intCodec{})
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Error("File should not be written before Get")
+		}
+		if got := first.Get(); got != 42 {
+			t.Errorf("First instance Get() = %v, want 42", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Compute called %d times, want 1", callCount)
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("File should exist after Get: %v", err)
+		}
+
+		second := NewLazyPersistent(path, compute, intCodec{})
+		if got := second.Get(); got != 42 {
+			t.Errorf("Second instance Get() = %v, want 42", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Compute called %d times after loading from file, want 1", callCount)
+		}
+	})
+
+	t.Run("cached in memory", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "value")
+		callCount := 0
+		val := NewLazyPersistent(path, func() int {
+			callCount++
+			return 7
+		}, intCodec{})
+
+		if val.IsEvaluated() {
+			t.Error("IsEvaluated() before Get = true, want false")
+		}
+		val.Get()
+		if got, ok := val.Peek(); !ok || got != 7 {
+			t.Errorf("Peek() after Get = %v, %v, want 7, true", got, ok)
+		}
+		os.Remove(path)
+		if got := val.Get(); got != 7 {
+			t.Errorf("Second Get() = %v, want 7", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Compute called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("undecodable file is recomputed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "value")
+		if err := os.WriteFile(path, []byte("not a number"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		val := NewLazyPersistent(path, func() int { return 3 }, intCodec{})
+		if got := val.Get(); got != 3 {
+			t.Errorf("Get() = %v, want 3", got)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package lazy
+
+import (
+	"sync"
+)
+
+type PipelineCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]Value[T]
+}
+
+func (c *PipelineCache[T]) Get(key string, build func() Value[T]) Value[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if shared, ok := c.entries[key]; ok {
+		return shared
+	}
+	if c.entries == nil {
+		c.entries = map[string]Value[T]{}
+	}
+	shared := NewOnce(func() T {
+		return build().Get()
+	}).describedAs("Pipeline(" + key + ")")
+	c.entries[key] = shared
+	return shared
+}
@@ -0,0 +1,72 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPipelineCache(t *testing.T) {
+	t.Run("same key shares one computation", func(t *testing.T) {
+		var cache PipelineCache[int]
+		builds, computes := 0, 0
+		build := func() Value[int] {
+			builds++
+			return Map(NewLazy(func() int {
+				computes++
+				return 20
+			}), func(x int) int { return x + 1 })
+		}
+
+		first := cache.Get("report", build)
+		second := cache.Get("report", build)
+		if builds != 0 || computes != 0 {
+			t.Error("Pipeline should not be built or forced before Get")
+		}
+
+		if got := first.Get(); got != 21 {
+			t.Errorf("first.Get() = %v, want 21", got)
+		}
+		if got := second.Get(); got != 21 {
+			t.Errorf("second.Get() = %v, want 21", got)
+		}
+		if builds != 1 || computes != 1 {
+			t.Errorf("Built %d and computed %d times, want 1 and 1", builds, computes)
+		}
+	})
+
+	t.Run("different keys are independent", func(t *testing.T) {
+		var cache PipelineCache[string]
+		a := cache.Get("a", func() Value[string] { return New("A") })
+		b := cache.Get("b", func() Value[string] { return New("B") })
+
+		if a.Get() != "A" || b.Get() != "B" {
+			t.Errorf("Get() = %v, %v, want A, B", a.Get(), b.Get())
+		}
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		var cache PipelineCache[int]
+		var computes atomic.Int32
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				val := cache.Get("shared", func() Value[int] {
+					return NewLazy(func() int {
+						computes.Add(1)
+						return 1
+					})
+				})
+				val.Get()
+			}()
+		}
+		wg.Wait()
+
+		if got := computes.Load(); got != 1 {
+			t.Errorf("Pipeline computed %d times, want 1", got)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package lazy
+
+import (
+	"reflect"
+	"sync"
+)
+
+var pools sync.Map
+
+func poolFor[T any]() *sync.Pool {
+	key := reflect.TypeFor[T]()
+	if p, ok := pools.Load(key); ok {
+		return p.(*sync.Pool)
+	}
+	p, _ := pools.LoadOrStore(key, &sync.Pool{
+		New: func() any {
+			return new(wrapper[T])
+		},
+	})
+	return p.(*sync.Pool)
+}
+
+func NewPooled[T any](value T) Value[T] {
+	w := poolFor[T]().Get().(*wrapper[T])
+	w.pooled = true
+	return Value[T]{
+		wrapper: w.set(value),
+		isLazy:  false,
+	}
+}
+
+func (l Value[T]) Release() {
+	if l.isLazy || l.wrapper == nil || !l.wrapper.pooled {
+		return
+	}
+	*l.wrapper = wrapper[T]{}
+	poolFor[T]().Put(l.wrapper)
+}
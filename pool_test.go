@@ -0,0 +1,86 @@
+package lazy
+
+import (
+	"testing"
+)
+
+var sink Value[int]
+
+func TestNewPooled(t *testing.T) {
+	t.Run("get value", func(t *testing.T) {
+		val := NewPooled(42)
+		if got := val.Get(); got != 42 {
+			t.Errorf("NewPooled(42).Get() = %v, want 42", got)
+		}
+		val.Release()
+	})
+
+	t.Run("reused wrapper is reset", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			val := NewPooled(i)
+			if got := val.Get(); got != i {
+				t.Errorf("NewPooled(%d).Get() = %v, want %d", i, got, i)
+			}
+			val.Release()
+		}
+	})
+
+	t.Run("release lazy and zero values is a no-op", func(t *testing.T) {
+		NewLazy(func() int { return 1 }).Release()
+		var zero Value[int]
+		zero.Release()
+	})
+
+	t.Run("release ignores values not from NewPooled", func(t *testing.T) {
+		a, b := Tee(NewLazy(func() int { return 1 }))
+		a.Get()
+		a.Release()
+		once := NewOnce(func() int { return 2 })
+		once.Get()
+		once.Release()
+		plain := New(3)
+		plain.Release()
+
+		for i := 0; i < 10; i++ {
+			NewPooled(99)
+		}
+		if got := b.Get(); got != 1 {
+			t.Errorf("Tee branch Get() after Release = %v, want 1", got)
+		}
+		if got := once.Get(); got != 2 {
+			t.Errorf("NewOnce Get() after Release = %v, want 2", got)
+		}
+		if got := plain.Get(); got != 3 {
+			t.Errorf("New Get() after Release = %v, want 3", got)
+		}
+	})
+
+	t.Run("fewer allocations than New", func(t *testing.T) {
+		plain := testing.AllocsPerRun(100, func() {
+			sink = New(1)
+		})
+		pooled := testing.AllocsPerRun(100, func() {
+			sink = NewPooled(1)
+			sink.Release()
+		})
+
+		if pooled >= plain {
+			t.Errorf("NewPooled allocs = %v, want fewer than New allocs = %v", pooled, plain)
+		}
+	})
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = New(i)
+	}
+}
+
+func BenchmarkNewPooled(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = NewPooled(i)
+		sink.Release()
+	}
+}
@@ -0,0 +1,19 @@
+package lazy
+
+import (
+	"slices"
+)
+
+func Any[T any](v Value[[]T], pred func(T) bool) Value[bool] {
+	return NewLazy(func() bool {
+		return slices.ContainsFunc(v.Get(), pred)
+	}).describedAs("Any", v)
+}
+
+func All[T any](v Value[[]T], pred func(T) bool) Value[bool] {
+	return NewLazy(func() bool {
+		return !slices.ContainsFunc(v.Get(), func(x T) bool {
+			return !pred(x)
+		})
+	}).describedAs("All", v)
+}
@@ -0,0 +1,67 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestAnyAll(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	tests := []struct {
+		name    string
+		values  []int
+		wantAny bool
+		wantAll bool
+	}{
+		{"all true", []int{2, 4, 6}, true, true},
+		{"some true", []int{1, 2, 3}, true, false},
+		{"none true", []int{1, 3, 5}, false, false},
+		{"empty", nil, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val := New(tt.values)
+			if got := Any(val, isEven).Get(); got != tt.wantAny {
+				t.Errorf("Any(%v, even).Get() = %v, want %v", tt.values, got, tt.wantAny)
+			}
+			if got := All(val, isEven).Get(); got != tt.wantAll {
+				t.Errorf("All(%v, even).Get() = %v, want %v", tt.values, got, tt.wantAll)
+			}
+		})
+	}
+
+	t.Run("early exit", func(t *testing.T) {
+		checked := 0
+		counting := func(x int) bool {
+			checked++
+			return isEven(x)
+		}
+		val := New([]int{1, 2, 3, 4})
+
+		Any(val, counting).Get()
+		if checked != 2 {
+			t.Errorf("Any checked %d elements, want 2", checked)
+		}
+
+		checked = 0
+		All(val, counting).Get()
+		if checked != 1 {
+			t.Errorf("All checked %d elements, want 1", checked)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		source := NewLazy(func() []int {
+			called = true
+			return nil
+		})
+		Any(source, isEven)
+		All(source, isEven)
+
+		if called {
+			t.Error("Source should not be forced during Any or All")
+		}
+	})
+}
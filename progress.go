@@ -0,0 +1,21 @@
+package lazy
+
+type Progress[T any] struct {
+	f func(report func(float64)) T
+}
+
+func NewLazyProgress[T any](f func(report func(float64)) T) Progress[T] {
+	return Progress[T]{f: f}
+}
+
+func (p Progress[T]) Get() T {
+	return p.f(func(float64) {})
+}
+
+func (p Progress[T]) GetWithProgress(cb func(float64)) T {
+	return p.f(cb)
+}
+
+func (p Progress[T]) AsValue() Value[T] {
+	return NewLazy(p.Get)
+}
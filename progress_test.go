@@ -0,0 +1,69 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestNewLazyProgress(t *testing.T) {
+	load := func(report func(float64)) int {
+		total := 0
+		for i := 1; i <= 4; i++ {
+			total += i
+			report(float64(i) / 4)
+		}
+		return total
+	}
+
+	t.Run("forwards progress", func(t *testing.T) {
+		val := NewLazyProgress(load)
+
+		var reports []float64
+		got := val.GetWithProgress(func(p float64) {
+			reports = append(reports, p)
+		})
+
+		if got != 10 {
+			t.Errorf("GetWithProgress() = %v, want 10", got)
+		}
+		if len(reports) != 4 {
+			t.Fatalf("Received %d progress reports, want 4", len(reports))
+		}
+		for i := 1; i < len(reports); i++ {
+			if reports[i] <= reports[i-1] {
+				t.Errorf("Progress reports %v are not increasing", reports)
+			}
+		}
+		if reports[len(reports)-1] != 1 {
+			t.Errorf("Final progress = %v, want 1", reports[len(reports)-1])
+		}
+	})
+
+	t.Run("plain Get ignores progress", func(t *testing.T) {
+		val := NewLazyProgress(load)
+		if got := val.Get(); got != 10 {
+			t.Errorf("Get() = %v, want 10", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		NewLazyProgress(func(report func(float64)) int {
+			called = true
+			return 0
+		})
+
+		if called {
+			t.Error("Function should not be called during NewLazyProgress")
+		}
+	})
+
+	t.Run("as value", func(t *testing.T) {
+		val := NewLazyProgress(load).AsValue()
+		if got := val.Get(); got != 10 {
+			t.Errorf("AsValue().Get() = %v, want 10", got)
+		}
+		if got := val.Describe(); got != "Lazy" {
+			t.Errorf("AsValue().Describe() = %q, want %q", got, "Lazy")
+		}
+	})
+}
@@ -0,0 +1,12 @@
+package lazy
+
+import (
+	"math/rand"
+)
+
+func NewLazyRand[T any](f func(*rand.Rand) T, seed int64) Value[T] {
+	r := rand.New(rand.NewSource(seed))
+	return NewLazy(func() T {
+		return f(r)
+	})
+}
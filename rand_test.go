@@ -0,0 +1,42 @@
+package lazy
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewLazyRand(t *testing.T) {
+	t.Run("same seed same sequence", func(t *testing.T) {
+		a := NewLazyRand(func(r *rand.Rand) int { return r.Int() }, 42)
+		b := NewLazyRand(func(r *rand.Rand) int { return r.Int() }, 42)
+
+		for i := 0; i < 5; i++ {
+			if gotA, gotB := a.Get(), b.Get(); gotA != gotB {
+				t.Errorf("Get() #%d = %v and %v, want identical values", i, gotA, gotB)
+			}
+		}
+	})
+
+	t.Run("generator is lazy", func(t *testing.T) {
+		called := false
+		NewLazyRand(func(r *rand.Rand) int {
+			called = true
+			return r.Int()
+		}, 1)
+
+		if called {
+			t.Error("Generator should not be called during NewLazyRand")
+		}
+	})
+
+	t.Run("successive gets advance the source", func(t *testing.T) {
+		val := NewLazyRand(func(r *rand.Rand) int64 { return r.Int63() }, 7)
+		expected := rand.New(rand.NewSource(7))
+
+		for i := 0; i < 3; i++ {
+			if got, want := val.Get(), expected.Int63(); got != want {
+				t.Errorf("Get() #%d = %v, want %v", i, got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,20 @@
+package lazy
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+var readOnlySafeMode atomic.Bool
+
+func SetReadOnlySafeMode(on bool) {
+	readOnlySafeMode.Store(on)
+}
+
+func GetReadOnly[T any](v Value[[]T]) []T {
+	values := v.Get()
+	if readOnlySafeMode.Load() {
+		return slices.Clone(values)
+	}
+	return values
+}
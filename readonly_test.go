@@ -0,0 +1,42 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestGetReadOnly(t *testing.T) {
+	t.Run("default returns the slice", func(t *testing.T) {
+		backing := []int{1, 2, 3}
+		got := GetReadOnly(New(backing))
+
+		if len(got) != 3 || &got[0] != &backing[0] {
+			t.Error("GetReadOnly should return the forced slice without copying by default")
+		}
+	})
+
+	t.Run("safe mode returns a copy", func(t *testing.T) {
+		SetReadOnlySafeMode(true)
+		defer SetReadOnlySafeMode(false)
+
+		backing := []int{1, 2, 3}
+		got := GetReadOnly(New(backing))
+
+		if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+			t.Fatalf("GetReadOnly() = %v, want [1 2 3]", got)
+		}
+		got[0] = 100
+		if backing[0] != 1 {
+			t.Errorf("Backing slice[0] = %v after mutating the result, want 1", backing[0])
+		}
+	})
+
+	t.Run("safe mode keeps nil", func(t *testing.T) {
+		SetReadOnlySafeMode(true)
+		defer SetReadOnlySafeMode(false)
+
+		var val Value[[]string]
+		if got := GetReadOnly(val); got != nil {
+			t.Errorf("GetReadOnly() on zero Value = %v, want nil", got)
+		}
+	})
+}
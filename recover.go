@@ -0,0 +1,20 @@
+package lazy
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+func RecoverWithStack[T any](v Value[T], fallback T) (Value[T], *string) {
+	stack := new(string)
+	recovered := NewLazy(func() (result T) {
+		defer func() {
+			if r := recover(); r != nil {
+				*stack = fmt.Sprintf("panic: %v\n\n%s", r, debug.Stack())
+				result = fallback
+			}
+		}()
+		return v.Get()
+	}).describedAs("RecoverWithStack", v)
+	return recovered, stack
+}
@@ -0,0 +1,60 @@
+package lazy
+
+import (
+	"strings"
+	"testing"
+)
+
+func panickingThunk() int {
+	panic("thunk failed")
+}
+
+func TestRecoverWithStack(t *testing.T) {
+	t.Run("panic returns fallback and stack", func(t *testing.T) {
+		val, stack := RecoverWithStack(NewLazy(panickingThunk), -1)
+
+		if *stack != "" {
+			t.Error("Stack should be empty before Get")
+		}
+		if got := val.Get(); got != -1 {
+			t.Errorf("Get() = %v, want fallback -1", got)
+		}
+		if *stack == "" {
+			t.Fatal("Stack should be captured after a panic")
+		}
+		if !strings.Contains(*stack, "thunk failed") {
+			t.Errorf("Stack does not mention the panic value:\n%s", *stack)
+		}
+		if !strings.Contains(*stack, "panickingThunk") {
+			t.Errorf("Stack does not reference the panicking thunk:\n%s", *stack)
+		}
+	})
+
+	t.Run("no panic", func(t *testing.T) {
+		val, stack := RecoverWithStack(New(5), -1)
+
+		if got := val.Get(); got != 5 {
+			t.Errorf("Get() = %v, want 5", got)
+		}
+		if *stack != "" {
+			t.Errorf("Stack = %q, want empty", *stack)
+		}
+	})
+
+	t.Run("panic inside pipeline stage", func(t *testing.T) {
+		pipeline := Map(New(1), func(x int) int {
+			if x > 0 {
+				panic("stage failed")
+			}
+			return x
+		})
+		val, stack := RecoverWithStack(pipeline, 0)
+
+		if got := val.Get(); got != 0 {
+			t.Errorf("Get() = %v, want fallback 0", got)
+		}
+		if !strings.Contains(*stack, "stage failed") {
+			t.Errorf("Stack does not mention the panic value:\n%s", *stack)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package lazy
+
+func ReduceTree[T any](vs []Value[T], op func(T, T) T) Value[T] {
+	sources := make([]describer, len(vs))
+	for i, v := range vs {
+		sources[i] = v
+	}
+	return NewLazy(func() T {
+		if len(vs) == 0 {
+			var zero T
+			return zero
+		}
+		return reduceTree(vs, op)
+	}).describedAs("ReduceTree", sources...)
+}
+
+func reduceTree[T any](vs []Value[T], op func(T, T) T) T {
+	if len(vs) == 1 {
+		return vs[0].Get()
+	}
+	mid := len(vs) / 2
+
+	type outcome struct {
+		value     T
+		recovered any
+		ok        bool
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		var o outcome
+		defer func() {
+			if r := recover(); r != nil {
+				o.recovered = r
+			}
+			done <- o
+		}()
+		o.value = reduceTree(vs[:mid], op)
+		o.ok = true
+	}()
+
+	right := reduceTree(vs[mid:], op)
+	left := <-done
+	if !left.ok {
+		panic(left.recovered)
+	}
+	return op(left.value, right)
+}
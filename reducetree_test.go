@@ -0,0 +1,91 @@
+package lazy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReduceTree(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	t.Run("sum", func(t *testing.T) {
+		vs := make([]Value[int], 100)
+		for i := range vs {
+			vs[i] = New(i + 1)
+		}
+
+		if got := ReduceTree(vs, add).Get(); got != 5050 {
+			t.Errorf("ReduceTree(1..100, +).Get() = %v, want 5050", got)
+		}
+	})
+
+	t.Run("preserves order for associative op", func(t *testing.T) {
+		vs := []Value[string]{New("a"), New("b"), New("c"), New("d"), New("e")}
+		concat := func(a, b string) string { return a + b }
+
+		if got := ReduceTree(vs, concat).Get(); got != "abcde" {
+			t.Errorf("ReduceTree(concat).Get() = %q, want %q", got, "abcde")
+		}
+	})
+
+	t.Run("empty and single", func(t *testing.T) {
+		if got := ReduceTree(nil, add).Get(); got != 0 {
+			t.Errorf("ReduceTree(nil).Get() = %v, want 0", got)
+		}
+		if got := ReduceTree([]Value[int]{New(7)}, add).Get(); got != 7 {
+			t.Errorf("ReduceTree([7]).Get() = %v, want 7", got)
+		}
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		called := false
+		ReduceTree([]Value[int]{NewLazy(func() int {
+			called = true
+			return 1
+		})}, add)
+
+		if called {
+			t.Error("Values should not be forced during ReduceTree")
+		}
+	})
+
+	t.Run("sub-reductions run in parallel", func(t *testing.T) {
+		vs := make([]Value[int], 8)
+		for i := range vs {
+			vs[i] = NewLazy(func() int {
+				time.Sleep(50 * time.Millisecond)
+				return 1
+			})
+		}
+
+		start := time.Now()
+		got := ReduceTree(vs, add).Get()
+		elapsed := time.Since(start)
+
+		if got != 8 {
+			t.Errorf("ReduceTree().Get() = %v, want 8", got)
+		}
+		if elapsed >= 8*50*time.Millisecond {
+			t.Errorf("ReduceTree took %v, want less than sequential 400ms", elapsed)
+		}
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		val := ReduceTree([]Value[int]{New(1), NewLazy(func() int { return 2 })}, add)
+		if got := val.Describe(); got != "ReduceTree(Value, Lazy)" {
+			t.Errorf("Describe() = %q, want %q", got, "ReduceTree(Value, Lazy)")
+		}
+	})
+
+	t.Run("panic propagates to Get", func(t *testing.T) {
+		vs := []Value[int]{New(1), NewLazy(func() int { panic("bad") }), New(3), New(4)}
+
+		defer func() {
+			if r := recover(); r != "bad" {
+				t.Errorf("recovered %v, want 'bad'", r)
+			}
+		}()
+		ReduceTree(vs, add).Get()
+		t.Error("Get() should panic when a value panics")
+	})
+}
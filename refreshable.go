@@ -0,0 +1,29 @@
+package lazy
+
+import (
+	"sync"
+)
+
+func Refreshable[T any](f func() T) (Value[T], func()) {
+	var (
+		mu     sync.Mutex
+		cached bool
+		value  T
+	)
+	val := NewLazy(func() T {
+		mu.Lock()
+		defer mu.Unlock()
+		if !cached {
+			value = f()
+			cached = true
+		}
+		return value
+	}).describedAs("Refreshable")
+
+	refresh := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		cached = false
+	}
+	return val, refresh
+}
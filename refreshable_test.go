@@ -0,0 +1,59 @@
+package lazy
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRefreshable(t *testing.T) {
+	t.Run("caches until refresh", func(t *testing.T) {
+		callCount := 0
+		val, refresh := Refreshable(func() int {
+			callCount++
+			return callCount
+		})
+
+		if callCount != 0 {
+			t.Error("Function should not be called during Refreshable")
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("First Get() = %v, want 1", got)
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("Second Get() = %v, want cached 1", got)
+		}
+
+		refresh()
+		if callCount != 1 {
+			t.Error("Refresh should not recompute eagerly")
+		}
+		if got := val.Get(); got != 2 {
+			t.Errorf("Get() after refresh = %v, want 2", got)
+		}
+		if got := val.Get(); got != 2 {
+			t.Errorf("Second Get() after refresh = %v, want cached 2", got)
+		}
+	})
+
+	t.Run("concurrent get and refresh", func(t *testing.T) {
+		val, refresh := Refreshable(func() int {
+			return 42
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				if got := val.Get(); got != 42 {
+					t.Errorf("Get() = %v, want 42", got)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				refresh()
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,23 @@
+package lazy
+
+import (
+	"fmt"
+	"sync"
+)
+
+func ReplayFrom[T any](values ...T) Value[T] {
+	var (
+		mu   sync.Mutex
+		next int
+	)
+	return NewLazy(func() T {
+		mu.Lock()
+		defer mu.Unlock()
+		if next >= len(values) {
+			panic(fmt.Sprintf("lazy: ReplayFrom exhausted after %d values", len(values)))
+		}
+		value := values[next]
+		next++
+		return value
+	}).describedAs("Replay")
+}
@@ -0,0 +1,51 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestReplayFrom(t *testing.T) {
+	t.Run("returns values in order", func(t *testing.T) {
+		val := ReplayFrom(1, 2, 3)
+
+		for i, want := range []int{1, 2, 3} {
+			if got := val.Get(); got != want {
+				t.Errorf("Get() #%d = %v, want %v", i+1, got, want)
+			}
+		}
+	})
+
+	t.Run("drives a dependent pipeline", func(t *testing.T) {
+		doubled := Map(ReplayFrom(5, 10), func(x int) int {
+			return x * 2
+		})
+
+		if got := doubled.Get(); got != 10 {
+			t.Errorf("First Get() = %v, want 10", got)
+		}
+		if got := doubled.Get(); got != 20 {
+			t.Errorf("Second Get() = %v, want 20", got)
+		}
+	})
+
+	t.Run("panics when exhausted", func(t *testing.T) {
+		val := ReplayFrom("only")
+		val.Get()
+
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Get() past the last value should panic")
+			}
+		}()
+		val.Get()
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Get() with no values should panic")
+			}
+		}()
+		ReplayFrom[int]().Get()
+	})
+}
@@ -0,0 +1,30 @@
+package lazy
+
+import (
+	"reflect"
+)
+
+func (l Value[T]) SafeGet() T {
+	value := l.Get()
+	rv := reflect.ValueOf(&value).Elem()
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return value
+		}
+		clone := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(clone, rv)
+		rv.Set(clone)
+	case reflect.Map:
+		if rv.IsNil() {
+			return value
+		}
+		clone := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), iter.Value())
+		}
+		rv.Set(clone)
+	}
+	return value
+}
@@ -0,0 +1,51 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestSafeGet(t *testing.T) {
+	t.Run("slice copies do not alias", func(t *testing.T) {
+		val := New([]int{1, 2, 3})
+		first := val.SafeGet()
+		second := val.SafeGet()
+
+		first[0] = 100
+		if second[0] != 1 {
+			t.Errorf("second SafeGet()[0] = %v after mutating first, want 1", second[0])
+		}
+		if got := val.Get(); got[0] != 1 {
+			t.Errorf("Get()[0] = %v after mutating SafeGet result, want 1", got[0])
+		}
+	})
+
+	t.Run("map copies do not alias", func(t *testing.T) {
+		shared := map[string]int{"a": 1}
+		val := New(shared)
+
+		first := val.SafeGet()
+		second := val.SafeGet()
+		first["a"] = 100
+		first["b"] = 2
+
+		if second["a"] != 1 || len(second) != 1 {
+			t.Errorf("second SafeGet() = %v after mutating first, want map[a:1]", second)
+		}
+		if shared["a"] != 1 || len(shared) != 1 {
+			t.Errorf("wrapped map = %v after mutating SafeGet result, want map[a:1]", shared)
+		}
+	})
+
+	t.Run("nil slice stays nil", func(t *testing.T) {
+		var val Value[[]int]
+		if got := val.SafeGet(); got != nil {
+			t.Errorf("SafeGet() on zero Value = %v, want nil", got)
+		}
+	})
+
+	t.Run("non-reference type", func(t *testing.T) {
+		if got := New(42).SafeGet(); got != 42 {
+			t.Errorf("New(42).SafeGet() = %v, want 42", got)
+		}
+	})
+}
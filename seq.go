@@ -0,0 +1,15 @@
+package lazy
+
+import (
+	"iter"
+)
+
+func Seq2[K comparable, V any](m map[K]Value[V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v.Get()) {
+				return
+			}
+		}
+	}
+}
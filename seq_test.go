@@ -0,0 +1,52 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestSeq2(t *testing.T) {
+	t.Run("collects all pairs", func(t *testing.T) {
+		m := map[string]Value[int]{
+			"a": New(1),
+			"b": NewLazy(func() int { return 2 }),
+			"c": Map(New(1), func(x int) int { return x + 2 }),
+		}
+
+		got := map[string]int{}
+		for k, v := range Seq2(m) {
+			got[k] = v
+		}
+
+		if len(got) != 3 || got["a"] != 1 || got["b"] != 2 || got["c"] != 3 {
+			t.Errorf("Seq2 collected %v, want map[a:1 b:2 c:3]", got)
+		}
+	})
+
+	t.Run("forces only iterated keys", func(t *testing.T) {
+		forced := map[string]bool{}
+		m := map[string]Value[int]{}
+		for _, k := range []string{"a", "b", "c"} {
+			m[k] = NewLazy(func() int {
+				forced[k] = true
+				return 0
+			})
+		}
+
+		for k := range Seq2(m) {
+			if !forced[k] {
+				t.Errorf("Key %q yielded before being forced", k)
+			}
+			break
+		}
+
+		if len(forced) != 1 {
+			t.Errorf("Forced %d values after early break, want 1", len(forced))
+		}
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		for range Seq2[string, int](nil) {
+			t.Error("Seq2(nil) should not yield")
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package lazy
+
+import (
+	"os"
+	"os/signal"
+)
+
+func NewLazyOnSignal(sig os.Signal) Value[struct{}] {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+	return NewOnce(func() struct{} {
+		<-ch
+		signal.Stop(ch)
+		return struct{}{}
+	}).describedAs("OnSignal")
+}
@@ -0,0 +1,46 @@
+//go:build unix
+
+package lazy
+
+import (
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestNewLazyOnSignal(t *testing.T) {
+	val := NewLazyOnSignal(syscall.SIGUSR1)
+
+	done := make(chan struct{})
+	go func() {
+		val.Get()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Get() returned before the signal was sent")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Sending signal: %v", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Get() did not unblock after the signal was sent")
+	}
+
+	returned := make(chan struct{})
+	go func() {
+		val.Get()
+		close(returned)
+	}()
+	select {
+	case <-returned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Second Get() should return immediately")
+	}
+}
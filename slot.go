@@ -0,0 +1,44 @@
+package lazy
+
+import (
+	"sync/atomic"
+)
+
+type Slot[T any] struct {
+	wrapper atomic.Pointer[wrapper[T]]
+}
+
+func (s *Slot[T]) GetOrCompute(f func() T) T {
+	w := s.wrapper.Load()
+	if w == nil {
+		// Racing callers each build a candidate; the first one installed
+		// wins and the others use it.
+		candidate := &wrapper[T]{thunk: f}
+		if s.wrapper.CompareAndSwap(nil, candidate) {
+			w = candidate
+		} else {
+			w = s.wrapper.Load()
+		}
+	}
+	return w.Get()
+}
+
+func (s *Slot[T]) Get() T {
+	w := s.wrapper.Load()
+	if w == nil {
+		var zero T
+		return zero
+	}
+	return w.Get()
+}
+
+func (s *Slot[T]) AsValue() Value[T] {
+	return Value[T]{
+		wrapper: s.wrapper.Load(),
+		isLazy:  false,
+	}
+}
+
+func LazyInit[T any](target *Slot[T], f func() T) T {
+	return target.GetOrCompute(f)
+}
@@ -0,0 +1,180 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSlot(t *testing.T) {
+	t.Run("zero slot computes once", func(t *testing.T) {
+		var slot Slot[int]
+		var mu sync.Mutex
+		callCount := 0
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				got := slot.GetOrCompute(func() int {
+					mu.Lock()
+					callCount++
+					mu.Unlock()
+					return 42
+				})
+				if got != 42 {
+					t.Errorf("GetOrCompute() = %v, want 42", got)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if callCount != 1 {
+			t.Errorf("Compute function called %d times, want 1", callCount)
+		}
+		if got := slot.Get(); got != 42 {
+			t.Errorf("Get() after GetOrCompute = %v, want 42", got)
+		}
+	})
+
+	t.Run("initialized slot ignores f", func(t *testing.T) {
+		var slot Slot[int]
+		slot.GetOrCompute(func() int { return 7 })
+		got := slot.GetOrCompute(func() int {
+			t.Error("Compute function should not be called for an initialized Slot")
+			return 0
+		})
+		if got != 7 {
+			t.Errorf("GetOrCompute() = %v, want 7", got)
+		}
+	})
+
+	t.Run("cached zero result", func(t *testing.T) {
+		var slot Slot[string]
+		callCount := 0
+		compute := func() string {
+			callCount++
+			return ""
+		}
+
+		slot.GetOrCompute(compute)
+		slot.GetOrCompute(compute)
+		if callCount != 1 {
+			t.Errorf("Compute function called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("get before initialization", func(t *testing.T) {
+		var slot Slot[int]
+		if got := slot.Get(); got != 0 {
+			t.Errorf("Get() on a zero Slot = %v, want 0", got)
+		}
+		if slot.AsValue().IsEvaluated() {
+			t.Error("AsValue().IsEvaluated() on a zero Slot = true, want false")
+		}
+	})
+
+	t.Run("as value", func(t *testing.T) {
+		var slot Slot[int]
+		slot.GetOrCompute(func() int { return 1 })
+		val := slot.AsValue()
+		if !val.IsEvaluated() {
+			t.Error("AsValue().IsEvaluated() after GetOrCompute = false, want true")
+		}
+		if got := val.Get(); got != 1 {
+			t.Errorf("AsValue().Get() = %v, want 1", got)
+		}
+	})
+
+	t.Run("get during initialization", func(t *testing.T) {
+		var slot Slot[int]
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				slot.GetOrCompute(func() int { return 9 })
+			}()
+			go func() {
+				defer wg.Done()
+				if got := slot.Get(); got != 0 && got != 9 {
+					t.Errorf("Get() during initialization = %v, want 0 or 9", got)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Service struct {
+			config Slot[string]
+		}
+
+		var s Service
+		if got := s.config.GetOrCompute(func() string { return "loaded" }); got != "loaded" {
+			t.Errorf("GetOrCompute() = %v, want 'loaded'", got)
+		}
+	})
+}
+
+var lazyInitGlobal Slot[map[string]int]
+
+func TestLazyInit(t *testing.T) {
+	var callCount atomic.Int32
+	load := func() map[string]int {
+		callCount.Add(1)
+		return map[string]int{"answer": 42}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := LazyInit(&lazyInitGlobal, load); got["answer"] != 42 {
+				t.Errorf("LazyInit()[answer] = %v, want 42", got["answer"])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := callCount.Load(); got != 1 {
+		t.Errorf("Initializer called %d times, want 1", got)
+	}
+}
+
+var lazyInitMixedGlobal Slot[string]
+
+func TestLazyInitWithConcurrentGet(t *testing.T) {
+	var callCount atomic.Int32
+	load := func() string {
+		callCount.Add(1)
+		return "ready"
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if got := LazyInit(&lazyInitMixedGlobal, load); got != "ready" {
+				t.Errorf("LazyInit() = %q, want %q", got, "ready")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if got := lazyInitMixedGlobal.Get(); got != "" && got != "ready" {
+				t.Errorf("Get() during LazyInit = %q, want \"\" or %q", got, "ready")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := lazyInitMixedGlobal.Get(); got != "ready" {
+		t.Errorf("Get() after LazyInit = %q, want %q", got, "ready")
+	}
+	if got := callCount.Load(); got != 1 {
+		t.Errorf("Initializer called %d times, want 1", got)
+	}
+}
@@ -0,0 +1,16 @@
+package lazy
+
+import (
+	"time"
+)
+
+func SlowLog[T any](v Value[T], threshold time.Duration, logger func(d time.Duration)) Value[T] {
+	return NewLazy(func() T {
+		start := time.Now()
+		value := v.Get()
+		if elapsed := time.Since(start); elapsed > threshold {
+			logger(elapsed)
+		}
+		return value
+	}).describedAs("SlowLog", v)
+}
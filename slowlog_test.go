@@ -0,0 +1,59 @@
+package lazy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlowLog(t *testing.T) {
+	t.Run("logs slow computation", func(t *testing.T) {
+		var logged []time.Duration
+		slow := NewLazy(func() int {
+			time.Sleep(20 * time.Millisecond)
+			return 1
+		})
+		val := SlowLog(slow, 5*time.Millisecond, func(d time.Duration) {
+			logged = append(logged, d)
+		})
+
+		if got := val.Get(); got != 1 {
+			t.Errorf("Get() = %v, want 1", got)
+		}
+		if len(logged) != 1 {
+			t.Fatalf("Logger called %d times, want 1", len(logged))
+		}
+		if logged[0] < 20*time.Millisecond {
+			t.Errorf("Logged duration %v, want at least 20ms", logged[0])
+		}
+	})
+
+	t.Run("ignores fast computation", func(t *testing.T) {
+		called := false
+		val := SlowLog(New(1), time.Second, func(d time.Duration) {
+			called = true
+		})
+
+		if got := val.Get(); got != 1 {
+			t.Errorf("Get() = %v, want 1", got)
+		}
+		if called {
+			t.Error("Logger should not be called for a fast computation")
+		}
+	})
+
+	t.Run("times every force", func(t *testing.T) {
+		calls := 0
+		val := SlowLog(NewLazy(func() int {
+			time.Sleep(5 * time.Millisecond)
+			return 1
+		}), time.Millisecond, func(d time.Duration) {
+			calls++
+		})
+
+		val.Get()
+		val.Get()
+		if calls != 2 {
+			t.Errorf("Logger called %d times, want 2", calls)
+		}
+	})
+}
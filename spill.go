@@ -0,0 +1,67 @@
+package lazy
+
+import (
+	"sync"
+)
+
+type Spillable[T any] struct {
+	mu       sync.Mutex
+	f        func() T
+	path     string
+	codec    Codec[T]
+	value    T
+	inMemory bool
+	spilled  bool
+}
+
+func NewSpillable[T any](f func() T, path string, codec Codec[T]) *Spillable[T] {
+	return &Spillable[T]{
+		f:     f,
+		path:  path,
+		codec: codec,
+	}
+}
+
+func (s *Spillable[T]) Get() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inMemory {
+		return s.value
+	}
+	if s.spilled {
+		if loaded, err := load(s.path, s.codec); err == nil {
+			s.value, s.inMemory = loaded, true
+			return s.value
+		}
+		s.spilled = false
+	}
+	s.value, s.inMemory = s.f(), true
+	return s.value
+}
+
+func (s *Spillable[T]) Spill() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.inMemory {
+		return nil
+	}
+	if !s.spilled {
+		if err := save(s.path, s.value, s.codec); err != nil {
+			return err
+		}
+		s.spilled = true
+	}
+	var zero T
+	s.value, s.inMemory = zero, false
+	return nil
+}
+
+func (s *Spillable[T]) InMemory() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inMemory
+}
+
+func (s *Spillable[T]) AsValue() Value[T] {
+	return NewLazy(s.Get).describedAs("Spillable")
+}
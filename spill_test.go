@@ -0,0 +1,102 @@
+package lazy
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSpillable(t *testing.T) {
+	t.Run("spill and reload", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill")
+		callCount := 0
+		s := NewSpillable(func() []int {
+			callCount++
+			return []int{1, 2, 3}
+		}, path, GobCodec[[]int]{})
+
+		want := []int{1, 2, 3}
+		if got := s.Get(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Get() = %v, want %v", got, want)
+		}
+		if !s.InMemory() {
+			t.Error("Value should be in memory after Get")
+		}
+
+		if err := s.Spill(); err != nil {
+			t.Fatalf("Spill() error = %v", err)
+		}
+		if s.InMemory() {
+			t.Error("Value should not be in memory after Spill")
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("Spill file should exist: %v", err)
+		}
+
+		if got := s.Get(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Get() after Spill = %v, want %v", got, want)
+		}
+		if callCount != 1 {
+			t.Errorf("Function called %d times, want 1", callCount)
+		}
+		if !s.InMemory() {
+			t.Error("Value should be back in memory after reload")
+		}
+	})
+
+	t.Run("spill before get is a no-op", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill")
+		s := NewSpillable(func() int { return 1 }, path, JSONCodec[int]{})
+
+		if err := s.Spill(); err != nil {
+			t.Fatalf("Spill() error = %v", err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Error("Spill before Get should not write a file")
+		}
+	})
+
+	t.Run("missing spill file is recomputed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill")
+		callCount := 0
+		s := NewSpillable(func() int {
+			callCount++
+			return 9
+		}, path, JSONCodec[int]{})
+
+		s.Get()
+		s.Spill()
+		os.Remove(path)
+
+		if got := s.Get(); got != 9 {
+			t.Errorf("Get() = %v, want 9", got)
+		}
+		if callCount != 2 {
+			t.Errorf("Function called %d times, want 2", callCount)
+		}
+	})
+
+	t.Run("spill error keeps value in memory", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing", "spill")
+		s := NewSpillable(func() int { return 1 }, path, JSONCodec[int]{})
+
+		s.Get()
+		if err := s.Spill(); err == nil {
+			t.Error("Spill() to an invalid path should return an error")
+		}
+		if !s.InMemory() {
+			t.Error("Value should stay in memory when Spill fails")
+		}
+	})
+
+	t.Run("as value", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill")
+		s := NewSpillable(func() string { return "data" }, path, JSONCodec[string]{})
+		val := Map(s.AsValue(), func(x string) int { return len(x) })
+
+		if got := val.Get(); got != 4 {
+			t.Errorf("Map(AsValue(), len).Get() = %v, want 4", got)
+		}
+	})
+}
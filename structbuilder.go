@@ -0,0 +1,36 @@
+package lazy
+
+import "slices"
+
+type StructBuilder[S any] struct {
+	fields []Value[func(*S)]
+}
+
+func NewStructBuilder[S any]() StructBuilder[S] {
+	return StructBuilder[S]{}
+}
+
+func Field[S any, F any](b StructBuilder[S], v Value[F], set func(*S, F)) StructBuilder[S] {
+	setter := Map(v, func(value F) func(*S) {
+		return func(s *S) {
+			set(s, value)
+		}
+	})
+	return StructBuilder[S]{
+		fields: append(slices.Clip(b.fields), setter),
+	}
+}
+
+func (b StructBuilder[S]) Build() Value[S] {
+	sources := make([]describer, len(b.fields))
+	for i, field := range b.fields {
+		sources[i] = field
+	}
+	return NewLazy(func() S {
+		var s S
+		for _, field := range b.fields {
+			field.Get()(&s)
+		}
+		return s
+	}).describedAs("Struct", sources...)
+}
@@ -0,0 +1,71 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestStructBuilder(t *testing.T) {
+	type Config struct {
+		Host    string
+		Port    int
+		Verbose bool
+	}
+
+	t.Run("three lazy fields", func(t *testing.T) {
+		forced := 0
+		host := NewLazy(func() string { forced++; return "localhost" })
+		port := NewLazy(func() int { forced++; return 8080 })
+		verbose := NewLazy(func() bool { forced++; return true })
+
+		b := NewStructBuilder[Config]()
+		b = Field(b, host, func(c *Config, v string) { c.Host = v })
+		b = Field(b, port, func(c *Config, v int) { c.Port = v })
+		b = Field(b, verbose, func(c *Config, v bool) { c.Verbose = v })
+		config := b.Build()
+
+		if forced != 0 {
+			t.Errorf("Forced %d fields before Get, want 0", forced)
+		}
+		want := Config{Host: "localhost", Port: 8080, Verbose: true}
+		if got := config.Get(); got != want {
+			t.Errorf("Build().Get() = %+v, want %+v", got, want)
+		}
+		if forced != 3 {
+			t.Errorf("Forced %d fields during Get, want 3", forced)
+		}
+	})
+
+	t.Run("unset fields keep zero values", func(t *testing.T) {
+		b := Field(NewStructBuilder[Config](), New(1), func(c *Config, v int) { c.Port = v })
+
+		want := Config{Port: 1}
+		if got := b.Build().Get(); got != want {
+			t.Errorf("Build().Get() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("later fields override earlier ones", func(t *testing.T) {
+		setHost := func(c *Config, v string) { c.Host = v }
+		b := NewStructBuilder[Config]()
+		b = Field(b, New("first"), setHost)
+		b = Field(b, New("second"), setHost)
+
+		if got := b.Build().Get().Host; got != "second" {
+			t.Errorf("Build().Get().Host = %q, want %q", got, "second")
+		}
+	})
+
+	t.Run("builders can be reused", func(t *testing.T) {
+		setPort := func(c *Config, v int) { c.Port = v }
+		base := Field(NewStructBuilder[Config](), New("host"), func(c *Config, v string) { c.Host = v })
+		dev := Field(base, New(8080), setPort)
+		prod := Field(base, New(443), setPort)
+
+		if got := dev.Build().Get().Port; got != 8080 {
+			t.Errorf("dev Port = %v, want 8080", got)
+		}
+		if got := prod.Build().Get().Port; got != 443 {
+			t.Errorf("prod Port = %v, want 443", got)
+		}
+	})
+}
@@ -0,0 +1,6 @@
+package lazy
+
+func Tee[T any](v Value[T]) (Value[T], Value[T]) {
+	branch := NewOnce(v.Get).describedAs("Tee", v)
+	return branch, branch
+}
@@ -0,0 +1,85 @@
+package lazy
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	t.Run("branches share one evaluation", func(t *testing.T) {
+		callCount := 0
+		source := NewLazy(func() int {
+			callCount++
+			return callCount * 10
+		})
+		left, right := Tee(source)
+
+		if callCount != 0 {
+			t.Error("Source should not be forced during Tee")
+		}
+		if got := left.Get(); got != 10 {
+			t.Errorf("left.Get() = %v, want 10", got)
+		}
+		if got := right.Get(); got != 10 {
+			t.Errorf("right.Get() = %v, want 10", got)
+		}
+		if got := left.Get(); got != 10 {
+			t.Errorf("Second left.Get() = %v, want 10", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("independent pipelines", func(t *testing.T) {
+		callCount := 0
+		left, right := Tee(NewLazy(func() []int {
+			callCount++
+			return []int{1, 2, 3}
+		}))
+
+		sum := Map(left, func(xs []int) int {
+			total := 0
+			for _, x := range xs {
+				total += x
+			}
+			return total
+		})
+		count := Map(right, func(xs []int) int {
+			return len(xs)
+		})
+
+		if got := sum.Get(); got != 6 {
+			t.Errorf("sum.Get() = %v, want 6", got)
+		}
+		if got := count.Get(); got != 3 {
+			t.Errorf("count.Get() = %v, want 3", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("concurrent branches", func(t *testing.T) {
+		var mu sync.Mutex
+		callCount := 0
+		left, right := Tee(NewLazy(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			callCount++
+			return 1
+		}))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() { defer wg.Done(); left.Get() }()
+			go func() { defer wg.Done(); right.Get() }()
+		}
+		wg.Wait()
+
+		if callCount != 1 {
+			t.Errorf("Source called %d times, want 1", callCount)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package lazy
+
+type Tuple3[A any, B any, C any] struct {
+	a Value[A]
+	b Value[B]
+	c Value[C]
+}
+
+func NewTuple3[A any, B any, C any](a Value[A], b Value[B], c Value[C]) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{a: a, b: b, c: c}
+}
+
+func (t Tuple3[A, B, C]) A() A { return t.a.Get() }
+func (t Tuple3[A, B, C]) B() B { return t.b.Get() }
+func (t Tuple3[A, B, C]) C() C { return t.c.Get() }
+
+type Tuple4[A any, B any, C any, D any] struct {
+	a Value[A]
+	b Value[B]
+	c Value[C]
+	d Value[D]
+}
+
+func NewTuple4[A any, B any, C any, D any](a Value[A], b Value[B], c Value[C], d Value[D]) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{a: a, b: b, c: c, d: d}
+}
+
+func (t Tuple4[A, B, C, D]) A() A { return t.a.Get() }
+func (t Tuple4[A, B, C, D]) B() B { return t.b.Get() }
+func (t Tuple4[A, B, C, D]) C() C { return t.c.Get() }
+func (t Tuple4[A, B, C, D]) D() D { return t.d.Get() }
@@ -0,0 +1,61 @@
+package lazy
+
+import (
+	"testing"
+)
+
+func TestTuple3(t *testing.T) {
+	t.Run("accessors", func(t *testing.T) {
+		tuple := NewTuple3(New(1), New("two"), New(3.0))
+
+		if tuple.A() != 1 || tuple.B() != "two" || tuple.C() != 3.0 {
+			t.Errorf("Tuple3 = (%v, %v, %v), want (1, two, 3)", tuple.A(), tuple.B(), tuple.C())
+		}
+	})
+
+	t.Run("forces only accessed component", func(t *testing.T) {
+		forced := map[string]int{}
+		tuple := NewTuple3(
+			NewLazy(func() int { forced["a"]++; return 1 }),
+			NewLazy(func() string { forced["b"]++; return "b" }),
+			NewLazy(func() bool { forced["c"]++; return true }),
+		)
+
+		if len(forced) != 0 {
+			t.Errorf("Forced %v during NewTuple3, want none", forced)
+		}
+		if got := tuple.B(); got != "b" {
+			t.Errorf("B() = %v, want 'b'", got)
+		}
+		if forced["b"] != 1 || forced["a"] != 0 || forced["c"] != 0 {
+			t.Errorf("Forced %v after B(), want only b once", forced)
+		}
+	})
+}
+
+func TestTuple4(t *testing.T) {
+	t.Run("accessors", func(t *testing.T) {
+		tuple := NewTuple4(New(1), New("two"), New(3.0), New([]int{4}))
+
+		if tuple.A() != 1 || tuple.B() != "two" || tuple.C() != 3.0 || tuple.D()[0] != 4 {
+			t.Errorf("Tuple4 = (%v, %v, %v, %v), want (1, two, 3, [4])", tuple.A(), tuple.B(), tuple.C(), tuple.D())
+		}
+	})
+
+	t.Run("forces only accessed component", func(t *testing.T) {
+		forced := map[string]int{}
+		tuple := NewTuple4(
+			NewLazy(func() int { forced["a"]++; return 1 }),
+			NewLazy(func() int { forced["b"]++; return 2 }),
+			NewLazy(func() int { forced["c"]++; return 3 }),
+			NewLazy(func() int { forced["d"]++; return 4 }),
+		)
+
+		if got := tuple.D(); got != 4 {
+			t.Errorf("D() = %v, want 4", got)
+		}
+		if len(forced) != 1 || forced["d"] != 1 {
+			t.Errorf("Forced %v after D(), want only d once", forced)
+		}
+	})
+}
@@ -1,25 +1,60 @@
 package lazy
 
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
 type wrapper[T any] struct {
-	value T
+	value     T
+	thunk     func() T
+	once      sync.Once
+	done      atomic.Bool
+	pooled    bool
+	recovered any
 }
 
 func (w *wrapper[T]) Get() T {
+	w.once.Do(w.evaluate)
+	if w.recovered != nil {
+		panic(w.recovered)
+	}
 	return w.value
 }
 
+// evaluate runs the thunk and keeps its panic, if any, so that every Get
+// re-raises it instead of returning a zero value as if it had succeeded.
+func (w *wrapper[T]) evaluate() {
+	if w.thunk != nil {
+		defer func() {
+			if r := recover(); r != nil {
+				w.recovered = r
+			}
+		}()
+		w.value = w.thunk()
+		w.thunk = nil
+	}
+	w.done.Store(true)
+}
+
+func (w *wrapper[T]) set(value T) *wrapper[T] {
+	w.value = value
+	w.done.Store(true)
+	return w
+}
+
 type Value[T any] struct {
 	wrapper *wrapper[T]
 	lazy    func() T
 	isLazy  bool
+	desc    *descriptor
 }
 
 func New[T any](value T) Value[T] {
 	return Value[T]{
-		wrapper: &wrapper[T]{
-			value: value,
-		},
-		isLazy: false,
+		wrapper: new(wrapper[T]).set(value),
+		isLazy:  false,
 	}
 }
 
@@ -30,6 +65,16 @@ func NewLazy[T any](lazy func() T) Value[T] {
 	}
 }
 
+func NewOnce[T any](thunk func() T) Value[T] {
+	return Value[T]{
+		wrapper: &wrapper[T]{
+			thunk: thunk,
+		},
+		isLazy: false,
+		desc:   onceDesc,
+	}
+}
+
 func (l Value[T]) Get() T {
 	if l.isLazy {
 		return l.lazy()
@@ -40,3 +85,100 @@ func (l Value[T]) Get() T {
 	}
 	return l.wrapper.Get()
 }
+
+func (l Value[T]) IsEvaluated() bool {
+	return !l.isLazy && l.wrapper != nil && l.wrapper.done.Load()
+}
+
+func (l Value[T]) Peek() (T, bool) {
+	if !l.IsEvaluated() {
+		var zero T
+		return zero, false
+	}
+	return l.wrapper.value, true
+}
+
+func (l Value[T]) Describe() string {
+	if l.desc != nil {
+		return l.desc.String()
+	}
+	if l.isLazy {
+		return "Lazy"
+	}
+	return "Value"
+}
+
+// describedAs records the name and sources of a combinator. The description
+// is only assembled when Describe is called, so building deep pipelines stays
+// linear.
+func (l Value[T]) describedAs(name string, sources ...describer) Value[T] {
+	l.desc = &descriptor{name: name, sources: sources}
+	return l
+}
+
+type describer interface {
+	writeDesc(b *strings.Builder)
+}
+
+type descriptor struct {
+	name    string
+	sources []describer
+}
+
+var onceDesc = &descriptor{name: "Once"}
+
+func (d *descriptor) String() string {
+	if d.sources == nil {
+		return d.name
+	}
+	var b strings.Builder
+	d.write(&b)
+	return b.String()
+}
+
+func (d *descriptor) write(b *strings.Builder) {
+	b.WriteString(d.name)
+	if d.sources == nil {
+		return
+	}
+	b.WriteByte('(')
+	for i, source := range d.sources {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		source.writeDesc(b)
+	}
+	b.WriteByte(')')
+}
+
+func (l Value[T]) writeDesc(b *strings.Builder) {
+	if l.desc != nil {
+		l.desc.write(b)
+		return
+	}
+	b.WriteString(l.Describe())
+}
+
+func (l Value[T]) GetInto(dst *T) {
+	if dst == nil {
+		return
+	}
+	*dst = l.Get()
+}
+
+func (l *Value[T]) Prefetch() {
+	if l.isLazy || l.wrapper == nil {
+		return
+	}
+	w := l.wrapper
+	go func() {
+		// A panicking thunk must not take down the process from here; the
+		// wrapper keeps the panic and the next Get re-raises it.
+		defer func() { recover() }()
+		w.Get()
+	}()
+}
+
+func (l Value[T]) AsFunc() func() T {
+	return l.Get
+}
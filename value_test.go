@@ -1,6 +1,9 @@
 package lazy
 
 import (
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -107,6 +110,135 @@ func TestNewLazy(t *testing.T) {
 	})
 }
 
+func TestNewOnce(t *testing.T) {
+	t.Run("evaluates on first Get only", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() int {
+			callCount++
+			return callCount * 10
+		})
+
+		if callCount != 0 {
+			t.Error("Thunk should not be called during NewOnce")
+		}
+		if got := val.Get(); got != 10 {
+			t.Errorf("First Get() = %v, want 10", got)
+		}
+		if got := val.Get(); got != 10 {
+			t.Errorf("Second Get() = %v, want 10", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Thunk called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("copies share the cache", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() string {
+			callCount++
+			return "cached"
+		})
+		copied := val
+
+		val.Get()
+		if got := copied.Get(); got != "cached" {
+			t.Errorf("copied.Get() = %v, want 'cached'", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Thunk called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("zero int cached", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() int {
+			callCount++
+			return 0
+		})
+
+		for i := 0; i < 3; i++ {
+			if got := val.Get(); got != 0 {
+				t.Errorf("Get() = %v, want 0", got)
+			}
+		}
+		if callCount != 1 {
+			t.Errorf("Thunk returning 0 called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("zero string cached", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() string {
+			callCount++
+			return ""
+		})
+
+		val.Get()
+		val.Get()
+		if callCount != 1 {
+			t.Errorf("Thunk returning empty string called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("zero bool cached", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() bool {
+			callCount++
+			return false
+		})
+
+		val.Get()
+		val.Get()
+		if callCount != 1 {
+			t.Errorf("Thunk returning false called %d times, want 1", callCount)
+		}
+	})
+
+	t.Run("concurrent Get evaluates once", func(t *testing.T) {
+		var callCount atomic.Int32
+		val := NewOnce(func() int {
+			callCount.Add(1)
+			return 42
+		})
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				if got := val.Get(); got != 42 {
+					t.Errorf("Get() = %v, want 42", got)
+				}
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		if got := callCount.Load(); got != 1 {
+			t.Errorf("Thunk called %d times, want 1", got)
+		}
+	})
+
+	t.Run("map over once value", func(t *testing.T) {
+		callCount := 0
+		val := NewOnce(func() int {
+			callCount++
+			return 5
+		})
+		doubled := Map(val, func(x int) int { return x * 2 })
+
+		doubled.Get()
+		if got := doubled.Get(); got != 10 {
+			t.Errorf("Map(once(5), x*2).Get() = %v, want 10", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Thunk called %d times, want 1", callCount)
+		}
+	})
+}
+
 func TestGet(t *testing.T) {
 	t.Run("immediate value", func(t *testing.T) {
 		val := New(100)
@@ -246,3 +378,369 @@ func TestLazyEvaluation(t *testing.T) {
 		}
 	})
 }
+
+func TestDescribe(t *testing.T) {
+	t.Run("constructors", func(t *testing.T) {
+		if got := New(1).Describe(); got != "Value" {
+			t.Errorf("New(1).Describe() = %q, want %q", got, "Value")
+		}
+		if got := NewLazy(func() int { return 1 }).Describe(); got != "Lazy" {
+			t.Errorf("NewLazy(f).Describe() = %q, want %q", got, "Lazy")
+		}
+		var zero Value[int]
+		if got := zero.Describe(); got != "Value" {
+			t.Errorf("zero Value Describe() = %q, want %q", got, "Value")
+		}
+	})
+
+	t.Run("pipeline", func(t *testing.T) {
+		called := false
+		source := NewLazy(func() int {
+			called = true
+			return 1
+		})
+		pipeline := Map(FlatMap(source, func(x int) Value[int] {
+			return New(x + 1)
+		}), func(x int) int {
+			return x * 2
+		})
+
+		if got := pipeline.Describe(); got != "Map(FlatMap(Lazy))" {
+			t.Errorf("Describe() = %q, want %q", got, "Map(FlatMap(Lazy))")
+		}
+		if called {
+			t.Error("Describe should not force the pipeline")
+		}
+	})
+
+	t.Run("fallback chain", func(t *testing.T) {
+		val := FallbackChain(Map(New(1), func(x int) int { return x }), New(2))
+		if got := val.Describe(); got != "FallbackChain(Map(Value), Value)" {
+			t.Errorf("Describe() = %q, want %q", got, "FallbackChain(Map(Value), Value)")
+		}
+	})
+	t.Run("deep pipeline", func(t *testing.T) {
+		const depth = 20000
+		v := New(0)
+		for i := 0; i < depth; i++ {
+			v = Map(v, func(x int) int { return x + 1 })
+		}
+		want := strings.Repeat("Map(", depth) + "Value" + strings.Repeat(")", depth)
+		if got := v.Describe(); got != want {
+			t.Errorf("Describe() of %d nested Maps has length %d, want %d", depth, len(got), len(want))
+		}
+	})
+}
+
+func TestGetInto(t *testing.T) {
+	t.Run("large struct", func(t *testing.T) {
+		type Large struct {
+			Data [64]int
+			Name string
+		}
+
+		large := Large{Name: "large"}
+		for i := range large.Data {
+			large.Data[i] = i
+		}
+		val := New(large)
+
+		var dst Large
+		val.GetInto(&dst)
+		if dst != val.Get() {
+			t.Errorf("GetInto filled %+v, want %+v", dst, val.Get())
+		}
+	})
+
+	t.Run("lazy value", func(t *testing.T) {
+		val := NewLazy(func() string {
+			return "computed"
+		})
+
+		var dst string
+		val.GetInto(&dst)
+		if dst != "computed" {
+			t.Errorf("GetInto filled %v, want 'computed'", dst)
+		}
+	})
+
+	t.Run("nil dst is a no-op", func(t *testing.T) {
+		called := false
+		val := NewLazy(func() int {
+			called = true
+			return 1
+		})
+
+		val.GetInto(nil)
+		if called {
+			t.Error("GetInto(nil) should not force the value")
+		}
+	})
+}
+
+func TestPrefetch(t *testing.T) {
+	t.Run("memoized value evaluates once", func(t *testing.T) {
+		var callCount atomic.Int32
+		started := make(chan struct{})
+		val, _ := Tee(NewLazy(func() int {
+			callCount.Add(1)
+			close(started)
+			return 42
+		}))
+
+		val.Prefetch()
+		<-started
+		if got := val.Get(); got != 42 {
+			t.Errorf("Get() after Prefetch = %v, want 42", got)
+		}
+		if got := callCount.Load(); got != 1 {
+			t.Errorf("Thunk called %d times, want 1", got)
+		}
+	})
+
+	t.Run("get before prefetch completes", func(t *testing.T) {
+		var callCount atomic.Int32
+		val, _ := Tee(NewLazy(func() int {
+			callCount.Add(1)
+			return 7
+		}))
+
+		val.Prefetch()
+		if got := val.Get(); got != 7 {
+			t.Errorf("Get() = %v, want 7", got)
+		}
+		if got := callCount.Load(); got != 1 {
+			t.Errorf("Thunk called %d times, want 1", got)
+		}
+	})
+
+	t.Run("panicking thunk surfaces at next get", func(t *testing.T) {
+		started := make(chan struct{})
+		val, _ := Tee(NewLazy(func() int {
+			close(started)
+			panic("failed")
+		}))
+
+		val.Prefetch()
+		<-started
+		defer func() {
+			if r := recover(); r != "failed" {
+				t.Errorf("Get() after Prefetch recovered %v, want %q", r, "failed")
+			}
+		}()
+		val.Get()
+		t.Error("Get() after a panicking Prefetch should panic")
+	})
+
+	t.Run("re-evaluating value is not forced", func(t *testing.T) {
+		called := false
+		val := NewLazy(func() int {
+			called = true
+			return 1
+		})
+
+		val.Prefetch()
+		if called {
+			t.Error("Prefetch should not force a re-evaluating value")
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var val Value[int]
+		val.Prefetch()
+		if got := val.Get(); got != 0 {
+			t.Errorf("Get() on zero Value = %v, want 0", got)
+		}
+	})
+}
+
+func TestAsFunc(t *testing.T) {
+	t.Run("immediate value", func(t *testing.T) {
+		f := New(42).AsFunc()
+		if got := f(); got != 42 {
+			t.Errorf("AsFunc()() = %v, want 42", got)
+		}
+	})
+
+	t.Run("re-evaluating value", func(t *testing.T) {
+		callCount := 0
+		f := NewLazy(func() int {
+			callCount++
+			return callCount
+		}).AsFunc()
+
+		if callCount != 0 {
+			t.Error("AsFunc should not force the value")
+		}
+		if got := f(); got != 1 {
+			t.Errorf("First call = %v, want 1", got)
+		}
+		if got := f(); got != 2 {
+			t.Errorf("Second call = %v, want 2", got)
+		}
+	})
+
+	t.Run("memoized value", func(t *testing.T) {
+		callCount := 0
+		val, _ := Tee(NewLazy(func() int {
+			callCount++
+			return 7
+		}))
+		f := val.AsFunc()
+
+		f()
+		if got := f(); got != 7 {
+			t.Errorf("Second call = %v, want 7", got)
+		}
+		if callCount != 1 {
+			t.Errorf("Thunk called %d times, want 1", callCount)
+		}
+	})
+}
+
+func TestIsEvaluated(t *testing.T) {
+	t.Run("immediate value", func(t *testing.T) {
+		if !New(1).IsEvaluated() {
+			t.Error("New(1).IsEvaluated() = false, want true")
+		}
+		if !New(0).IsEvaluated() {
+			t.Error("New(0).IsEvaluated() = false, want true")
+		}
+	})
+
+	t.Run("pooled value", func(t *testing.T) {
+		val := NewPooled(1)
+		defer val.Release()
+		if !val.IsEvaluated() {
+			t.Error("NewPooled(1).IsEvaluated() = false, want true")
+		}
+	})
+
+	t.Run("re-evaluating value", func(t *testing.T) {
+		val := NewLazy(func() int { return 1 })
+		if val.IsEvaluated() {
+			t.Error("NewLazy(f).IsEvaluated() before Get = true, want false")
+		}
+		val.Get()
+		if val.IsEvaluated() {
+			t.Error("NewLazy(f).IsEvaluated() after Get = true, want false")
+		}
+	})
+
+	t.Run("memoized value", func(t *testing.T) {
+		called := false
+		val := NewOnce(func() int {
+			called = true
+			return 0
+		})
+
+		if val.IsEvaluated() {
+			t.Error("NewOnce(f).IsEvaluated() before Get = true, want false")
+		}
+		if called {
+			t.Error("IsEvaluated should not force the value")
+		}
+		val.Get()
+		if !val.IsEvaluated() {
+			t.Error("NewOnce(f).IsEvaluated() after Get = false, want true")
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var val Value[int]
+		if val.IsEvaluated() {
+			t.Error("zero Value IsEvaluated() = true, want false")
+		}
+	})
+
+	t.Run("panicking thunk", func(t *testing.T) {
+		calls := 0
+		val := NewOnce(func() int {
+			calls++
+			panic("failed")
+		})
+		for i := 0; i < 2; i++ {
+			func() {
+				defer func() {
+					if r := recover(); r != "failed" {
+						t.Errorf("Get() call %d recovered %v, want %q", i+1, r, "failed")
+					}
+				}()
+				val.Get()
+			}()
+		}
+		if calls != 1 {
+			t.Errorf("thunk called %d times, want 1", calls)
+		}
+		if val.IsEvaluated() {
+			t.Error("IsEvaluated() after a panicking thunk = true, want false")
+		}
+		if _, ok := val.Peek(); ok {
+			t.Error("Peek() after a panicking thunk reported a cached value")
+		}
+	})
+}
+
+func TestPeek(t *testing.T) {
+	t.Run("immediate value", func(t *testing.T) {
+		got, ok := New(42).Peek()
+		if !ok || got != 42 {
+			t.Errorf("New(42).Peek() = %v, %v, want 42, true", got, ok)
+		}
+	})
+
+	t.Run("memoized value", func(t *testing.T) {
+		called := false
+		val := NewOnce(func() string {
+			called = true
+			return "computed"
+		})
+
+		if got, ok := val.Peek(); ok || got != "" {
+			t.Errorf("Peek() before Get = %q, %v, want \"\", false", got, ok)
+		}
+		if called {
+			t.Error("Peek should not force the value")
+		}
+		if val.IsEvaluated() {
+			t.Error("IsEvaluated() after Peek = true, want false")
+		}
+
+		val.Get()
+		if got, ok := val.Peek(); !ok || got != "computed" {
+			t.Errorf("Peek() after Get = %q, %v, want 'computed', true", got, ok)
+		}
+	})
+
+	t.Run("re-evaluating value", func(t *testing.T) {
+		val := NewLazy(func() int { return 1 })
+		val.Get()
+		if got, ok := val.Peek(); ok || got != 0 {
+			t.Errorf("NewLazy(f).Peek() = %v, %v, want 0, false", got, ok)
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var val Value[int]
+		if got, ok := val.Peek(); ok || got != 0 {
+			t.Errorf("zero Value Peek() = %v, %v, want 0, false", got, ok)
+		}
+	})
+
+	t.Run("concurrent with Get", func(t *testing.T) {
+		val := NewOnce(func() int { return 7 })
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() { defer wg.Done(); val.Get() }()
+			go func() {
+				defer wg.Done()
+				if got, ok := val.Peek(); ok && got != 7 {
+					t.Errorf("Peek() = %v, true, want 7", got)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}